@@ -0,0 +1,240 @@
+package rbxweb
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+)
+
+// AssetsServiceV1 handles the 'assets/v1' Roblox Open Cloud API.
+//
+// Requests require an API key with the asset:read and asset:write permissions
+// to be set in the Client.
+type AssetsServiceV1 service
+
+// AssetID represents an Asset on Roblox.
+type AssetID int64
+
+// AssetType represents the type of an uploadable asset.
+type AssetType string
+
+const (
+	AssetTypeAudio AssetType = "Audio"
+	AssetTypeDecal AssetType = "Decal"
+	AssetTypeModel AssetType = "Model"
+)
+
+// AssetCreator implements the Creator Open Cloud model; only one of
+// UserID or GroupID is set.
+type AssetCreator struct {
+	UserID  CreatorID `json:"userId,string,omitempty"`
+	GroupID CreatorID `json:"groupId,string,omitempty"`
+}
+
+// AssetCreationContext implements the CreationContext Open Cloud model.
+type AssetCreationContext struct {
+	Creator       AssetCreator `json:"creator"`
+	ExpectedPrice int64        `json:"expectedPrice,omitempty"`
+}
+
+// AssetModerationResult implements the ModerationResult Open Cloud model.
+type AssetModerationResult struct {
+	State string `json:"moderationState"` // One of "Reviewing", "Rejected", "Approved"
+}
+
+// Asset implements the Asset Open Cloud model.
+type Asset struct {
+	ID               AssetID               `json:"assetId,string"`
+	Type             AssetType             `json:"assetType"`
+	Path             string                `json:"path"`
+	DisplayName      string                `json:"displayName"`
+	Description      string                `json:"description"`
+	RevisionID       string                `json:"revisionId"`
+	RevisionTime     string                `json:"revisionCreateTime"`
+	CreationContext  AssetCreationContext  `json:"creationContext"`
+	ModerationResult AssetModerationResult `json:"moderationResult"`
+	State            string                `json:"state"` // One of "Active", "Archived"
+}
+
+// AssetVersion implements the AssetVersion Open Cloud model.
+type AssetVersion struct {
+	Path             string                `json:"path"` // assets/{assetId}/versions/{version}
+	CreationContext  AssetCreationContext  `json:"creationContext"`
+	ModerationResult AssetModerationResult `json:"moderationResult"`
+	Published        bool                  `json:"published"`
+}
+
+// AssetCreate provides the parameters for creating an Asset.
+type AssetCreate struct {
+	Type            AssetType            `json:"assetType"`
+	DisplayName     string               `json:"displayName"`
+	Description     string               `json:"description"`
+	CreationContext AssetCreationContext `json:"creationContext"`
+}
+
+// assetMagic maps the header of asset files not detected, or detected
+// differently, by http.DetectContentType to the content type accepted by
+// the Assets API.
+var assetMagic = []struct {
+	prefix  string
+	content string
+}{
+	{"Kaydara FBX Binary", "model/fbx"},
+	{"glTF", "model/gltf-binary"},
+	{"<roblox!", "model/x-rbxm"},
+	{"<roblox", "model/x-rbxmx"},
+	{"fLaC", "audio/flac"},
+}
+
+// detectAssetContent returns the content type of the asset file, as
+// accepted by the Assets API.
+func detectAssetContent(br *bufio.Reader) string {
+	head, _ := br.Peek(512)
+	for _, m := range assetMagic {
+		if bytes.HasPrefix(head, []byte(m.prefix)) {
+			return m.content
+		}
+	}
+
+	switch content := http.DetectContentType(head); content {
+	case "application/ogg":
+		return "audio/ogg"
+	case "audio/wave":
+		return "audio/wav"
+	default:
+		return content
+	}
+}
+
+// uploadAsset streams the multipart request of the asset metadata and file
+// read from r, named as name.
+func (a *AssetsServiceV1) uploadAsset(method, path string, meta any, name string, r io.Reader) (*Operation[Asset], error) {
+	metaJSON, err := json.Marshal(meta)
+	if err != nil {
+		return nil, err
+	}
+
+	br := bufio.NewReader(r)
+	content := detectAssetContent(br)
+
+	pr, pw := io.Pipe()
+	mw := multipart.NewWriter(pw)
+	go func() {
+		err := mw.WriteField("request", string(metaJSON))
+		if err == nil {
+			var part io.Writer
+			part, err = mw.CreatePart(textproto.MIMEHeader{
+				"Content-Disposition": {fmt.Sprintf(`form-data; name="fileContent"; filename=%q`, name)},
+				"Content-Type":        {content},
+			})
+			if err == nil {
+				_, err = io.Copy(part, br)
+			}
+		}
+		if err == nil {
+			err = mw.Close()
+		}
+		pw.CloseWithError(err)
+	}()
+
+	req, err := a.Client.NewRequest(method, "apis", path, io.Reader(pr))
+	if err != nil {
+		pr.Close()
+		return nil, err
+	}
+	req.Header.Set("Content-Type", mw.FormDataContentType())
+
+	op := &Operation[Asset]{client: a.Client, base: "assets/v1/"}
+	if _, err := a.Client.Do(req, op); err != nil {
+		pr.Close()
+		return nil, err
+	}
+
+	return op, nil
+}
+
+// CreateAsset uploads a new asset with the file read from r, named as
+// name, and returns the operation of the asset's creation, which can be
+// waited upon for the created Asset. The content type of the file is detected
+// from its contents.
+func (a *AssetsServiceV1) CreateAsset(ac *AssetCreate, name string, r io.Reader) (*Operation[Asset], error) {
+	return a.uploadAsset("POST", "assets/v1/assets", ac, name, r)
+}
+
+// UpdateAsset uploads a new version of the given Asset ID with the file
+// read from r, named as name, and returns the operation of the asset's update.
+func (a *AssetsServiceV1) UpdateAsset(aid AssetID, name string, r io.Reader) (*Operation[Asset], error) {
+	meta := struct {
+		ID AssetID `json:"assetId,string"`
+	}{aid}
+
+	return a.uploadAsset("PATCH", path("assets/v1/assets/%d", nil, aid), meta, name, r)
+}
+
+// GetAsset returns the Asset of the given Asset ID.
+func (a *AssetsServiceV1) GetAsset(aid AssetID) (*Asset, error) {
+	var as Asset
+
+	err := a.Client.Execute("GET", "apis", path("assets/v1/assets/%d", nil, aid), nil, &as)
+	if err != nil {
+		return nil, err
+	}
+
+	return &as, nil
+}
+
+// ListAssetVersions returns a page of versions of the given Asset ID, and
+// the token of the next page, if any. The page token is optional.
+func (a *AssetsServiceV1) ListAssetVersions(aid AssetID, pageSize int, pageToken string) ([]AssetVersion, string, error) {
+	avr := struct {
+		Versions []AssetVersion `json:"assetVersions"`
+		Next     string         `json:"nextPageToken"`
+	}{}
+
+	err := a.Client.Execute("GET", "apis",
+		path("assets/v1/assets/%d/versions", cloudPageQuery(pageSize, pageToken), aid), nil, &avr)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return avr.Versions, avr.Next, nil
+}
+
+// RollbackAsset rolls back the given Asset ID to the named version number,
+// and returns the new AssetVersion.
+func (a *AssetsServiceV1) RollbackAsset(aid AssetID, version int64) (*AssetVersion, error) {
+	var av AssetVersion
+
+	req := struct {
+		Version string `json:"assetVersion"`
+	}{fmt.Sprintf("assets/%d/versions/%d", aid, version)}
+
+	err := a.Client.Execute("POST", "apis",
+		path("assets/v1/assets/%d/versions:rollback", nil, aid), req, &av)
+	if err != nil {
+		return nil, err
+	}
+
+	return &av, nil
+}
+
+// GetOperation returns the current state of the named operation ID, such
+// as one returned by CreateAsset or UpdateAsset.
+func (a *AssetsServiceV1) GetOperation(ctx context.Context, id string) (*Operation[Asset], error) {
+	op := &Operation[Asset]{
+		Path:   "operations/" + id,
+		client: a.Client,
+		base:   "assets/v1/",
+	}
+	if err := op.Poll(ctx); err != nil {
+		return nil, err
+	}
+
+	return op, nil
+}
@@ -0,0 +1,122 @@
+package rbxweb
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestDetectAssetContent(t *testing.T) {
+	tests := []struct {
+		name string
+		head string
+		want string
+	}{
+		{"png", "\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR", "image/png"},
+		{"jpeg", "\xff\xd8\xff\xe0\x00\x10JFIF", "image/jpeg"},
+		{"bmp", "BM\x36\x00\x0c\x00\x00\x00", "image/bmp"},
+		{"mp3", "ID3\x04\x00\x00\x00\x00\x00\x00", "audio/mpeg"},
+		{"ogg", "OggS\x00\x02\x00\x00\x00\x00", "audio/ogg"},
+		{"wav", "RIFF\x24\x08\x00\x00WAVEfmt ", "audio/wav"},
+		{"flac", "fLaC\x00\x00\x00\x22", "audio/flac"},
+		{"fbx", "Kaydara FBX Binary  \x00\x1a\x00", "model/fbx"},
+		{"glb", "glTF\x02\x00\x00\x00", "model/gltf-binary"},
+		{"rbxm", "<roblox!\x89\xff\r\n\x1a\n", "model/x-rbxm"},
+		{"rbxmx", `<roblox xmlns:xmime="http://www.w3.org/2005/05/xmlmime" version="4">`, "model/x-rbxmx"},
+		{"unknown", "\x00\x01\x02\x03", "application/octet-stream"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := detectAssetContent(bufio.NewReader(strings.NewReader(tt.head)))
+			if got != tt.want {
+				t.Errorf("content = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCreateAsset(t *testing.T) {
+	var meta AssetCreate
+	var file, content string
+
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/assets/v1/assets" {
+			t.Errorf("request = %s %s", r.Method, r.URL.Path)
+		}
+
+		_, params, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		mr := multipart.NewReader(r.Body, params["boundary"])
+		for {
+			part, err := mr.NextPart()
+			if err == io.EOF {
+				break
+			} else if err != nil {
+				t.Error(err)
+				return
+			}
+
+			b, _ := io.ReadAll(part)
+			switch part.FormName() {
+			case "request":
+				if err := json.Unmarshal(b, &meta); err != nil {
+					t.Error(err)
+				}
+			case "fileContent":
+				file = string(b)
+				content = part.Header.Get("Content-Type")
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		io.WriteString(w, `{"path":"operations/abc","done":false}`)
+	}))
+
+	ac := &AssetCreate{
+		Type:        AssetTypeModel,
+		DisplayName: "Sword",
+		CreationContext: AssetCreationContext{
+			Creator: AssetCreator{UserID: 1},
+		},
+	}
+	op, err := c.AssetsV1.CreateAsset(ac, "Sword.rbxm", strings.NewReader("<roblox!model"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if op.Path != "operations/abc" {
+		t.Errorf("operation = %s, want operations/abc", op.Path)
+	}
+	if meta != *ac {
+		t.Errorf("request = %+v, want %+v", meta, *ac)
+	}
+	if file != "<roblox!model" || content != "model/x-rbxm" {
+		t.Errorf("file = %q (%s), want %q (model/x-rbxm)", file, content, "<roblox!model")
+	}
+}
+
+func TestCreateAssetTokenRetry(t *testing.T) {
+	var bodies []string
+	c := newTestClient(t, newTokenHandler(&bodies))
+
+	_, err := c.AssetsV1.CreateAsset(&AssetCreate{Type: AssetTypeDecal}, "decal.png",
+		strings.NewReader("\x89PNG\r\n\x1a\n"))
+
+	var statusErr *StatusError
+	if !errors.As(err, &statusErr) || statusErr.StatusCode != http.StatusForbidden {
+		t.Fatalf("error = %v, want %d", err, http.StatusForbidden)
+	}
+	if len(bodies) != 1 {
+		t.Errorf("requests = %d, want 1", len(bodies))
+	}
+}
@@ -0,0 +1,139 @@
+package rbxweb
+
+import (
+	"iter"
+	"net/url"
+	"slices"
+	"time"
+)
+
+// BadgesServiceV1 partially handles the 'badges/v1' Roblox Web API.
+type BadgesServiceV1 service
+
+// BadgeID represents a Badge on Roblox.
+type BadgeID int64
+
+// badgesPerRequest is the maximum amount of badges accepted by batched
+// badge requests.
+const badgesPerRequest = 100
+
+// Badge implements the BadgeResponse API model.
+type Badge struct {
+	ID                 BadgeID `json:"id"`
+	Name               string  `json:"name"`
+	Description        string  `json:"description"`
+	DisplayName        string  `json:"displayName"`
+	DisplayDescription string  `json:"displayDescription"`
+	Enabled            bool    `json:"enabled"`
+	IconImageID        AssetID `json:"iconImageId"`
+	DisplayIconImageID AssetID `json:"displayIconImageId"`
+	Created            string  `json:"created"`
+	Updated            string  `json:"updated"`
+	Statistics         struct {
+		PastDayAwardedCount int64   `json:"pastDayAwardedCount"`
+		AwardedCount        int64   `json:"awardedCount"`
+		WinRatePercentage   float64 `json:"winRatePercentage"`
+	} `json:"statistics"`
+	AwardingUniverse struct {
+		ID          UniverseID `json:"id"`
+		Name        string     `json:"name"`
+		RootPlaceID PlaceID    `json:"rootPlaceId"`
+	} `json:"awardingUniverse"`
+}
+
+// BadgeAward implements the BadgeAwardResponse API model.
+type BadgeAward struct {
+	BadgeID BadgeID   `json:"badgeId"`
+	Date    time.Time `json:"awardedDate"`
+}
+
+// GetBadge returns the Badge of the given Badge ID.
+func (b *BadgesServiceV1) GetBadge(id BadgeID) (*Badge, error) {
+	var bd Badge
+
+	err := b.Client.Execute("GET", "badges", path("v1/badges/%d", nil, id), nil, &bd)
+	if err != nil {
+		return nil, err
+	}
+
+	return &bd, nil
+}
+
+// ListUniverseBadges returns a page of the badges of the given Universe ID.
+// The cursor is optional.
+func (b *BadgesServiceV1) ListUniverseBadges(uid UniverseID, cursor string) (*Page[Badge], error) {
+	var p Page[Badge]
+
+	q := url.Values{"limit": {"100"}}
+	if cursor != "" {
+		q.Set("cursor", cursor)
+	}
+
+	err := b.Client.Execute("GET", "badges", path("v1/universes/%d/badges", q, uid), nil, &p)
+	if err != nil {
+		return nil, err
+	}
+
+	return &p, nil
+}
+
+// UniverseBadges returns an iterator over the badges of all pages of
+// ListUniverseBadges.
+func (b *BadgesServiceV1) UniverseBadges(uid UniverseID) iter.Seq2[Badge, error] {
+	return paginate(func(cursor string) (*Page[Badge], error) {
+		return b.ListUniverseBadges(uid, cursor)
+	})
+}
+
+// ListUserBadges returns a page of the badges awarded to the given User ID,
+// most recent first. The cursor is optional.
+func (b *BadgesServiceV1) ListUserBadges(uid UserID, cursor string) (*Page[Badge], error) {
+	var p Page[Badge]
+
+	q := url.Values{
+		"limit":     {"100"},
+		"sortOrder": {string(SortOrderDesc)},
+	}
+	if cursor != "" {
+		q.Set("cursor", cursor)
+	}
+
+	err := b.Client.Execute("GET", "badges", path("v1/users/%d/badges", q, uid), nil, &p)
+	if err != nil {
+		return nil, err
+	}
+
+	return &p, nil
+}
+
+// UserBadges returns an iterator over the badges of all pages of
+// ListUserBadges.
+func (b *BadgesServiceV1) UserBadges(uid UserID) iter.Seq2[Badge, error] {
+	return paginate(func(cursor string) (*Page[Badge], error) {
+		return b.ListUserBadges(uid, cursor)
+	})
+}
+
+// ListAwardedDates returns the award dates of the given Badge IDs that were
+// awarded to the given User ID; badges not awarded are omitted. The Badge IDs
+// are requested in chunks, as accepted by the API.
+func (b *BadgesServiceV1) ListAwardedDates(uid UserID, ids []BadgeID) ([]BadgeAward, error) {
+	var awards []BadgeAward
+
+	for chunk := range slices.Chunk(ids, badgesPerRequest) {
+		bar := struct {
+			Data []BadgeAward `json:"data"`
+		}{}
+
+		q := url.Values{"badgeIds": formatSlice(chunk)}
+		err := b.Client.Execute("GET", "badges",
+			path("v1/users/%d/badges/awarded-dates", q, uid), nil, &bar)
+		if err != nil {
+			return nil, err
+		}
+
+		awards = append(awards, bar.Data...)
+	}
+
+	return awards, nil
+}
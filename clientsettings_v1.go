@@ -0,0 +1,29 @@
+package rbxweb
+
+import (
+	"net/url"
+)
+
+// ClientSettingsServiceV1 partially handles the 'clientsettings/v1' Roblox Web API.
+type ClientSettingsServiceV1 service
+
+// GetApplicationSettings returns the fast flags served for the named
+// application, such as PCDesktopClient. The channel is optional.
+func (c *ClientSettingsServiceV1) GetApplicationSettings(app, channel string) (ApplicationSettings, error) {
+	asr := struct {
+		Settings ApplicationSettings `json:"applicationSettings"`
+	}{}
+
+	q := url.Values{"applicationName": {app}}
+	if !IsLiveChannel(channel) {
+		q.Set("channel", channel)
+	}
+
+	err := c.Client.Execute("GET", "clientsettingscdn",
+		path("v1/settings/application", q), nil, &asr)
+	if err != nil {
+		return nil, err
+	}
+
+	return asr.Settings, nil
+}
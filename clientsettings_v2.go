@@ -1,7 +1,12 @@
 package rbxweb
 
 import (
+	"cmp"
+	"fmt"
+	"net/http"
 	"net/url"
+	"strconv"
+	"strings"
 )
 
 // ClientSettingsServiceV2 partially handles the 'clientsettings/v2' Roblox Web API.
@@ -11,25 +16,85 @@ type ClientSettingsServiceV2 service
 type BinaryType string
 
 const (
-	BinaryTypeWindowsPlayer   BinaryType = "WindowsPlayer"
-	BinaryTypeWindowsStudio   BinaryType = "WindowsStudio" // Deprecated in favor of WindowsStudio64, undocumented
-	BinaryTypeWindowsStudio64 BinaryType = "WindowsStudio64"
-	BinaryTypeMacPlayer       BinaryType = "MacPlayer"
-	BinaryTypeMacStudio       BinaryType = "MacStudio"
+	BinaryTypeWindowsPlayer      BinaryType = "WindowsPlayer"
+	BinaryTypeWindowsStudio      BinaryType = "WindowsStudio" // Deprecated in favor of WindowsStudio64, undocumented
+	BinaryTypeWindowsStudio64    BinaryType = "WindowsStudio64"
+	BinaryTypeWindowsStudioCJV   BinaryType = "WindowsStudioCJV"   // Undocumented
+	BinaryTypeWindowsStudio64CJV BinaryType = "WindowsStudio64CJV" // Undocumented
+	BinaryTypeMacPlayer          BinaryType = "MacPlayer"
+	BinaryTypeMacStudio          BinaryType = "MacStudio"
+	BinaryTypeMacStudioCJV       BinaryType = "MacStudioCJV"   // Undocumented
+	BinaryTypeMacPlayerArm64     BinaryType = "MacPlayerArm64" // Undocumented
+	BinaryTypeMacStudioArm64     BinaryType = "MacStudioArm64" // Undocumented
 )
 
 // Short returns the shortened form of BinaryType.
 func (bt BinaryType) Short() string {
 	switch bt {
-	case BinaryTypeWindowsPlayer, BinaryTypeMacPlayer:
+	case BinaryTypeWindowsPlayer, BinaryTypeMacPlayer, BinaryTypeMacPlayerArm64:
 		return "Player"
-	case BinaryTypeWindowsStudio, BinaryTypeWindowsStudio64, BinaryTypeMacStudio:
+	case BinaryTypeWindowsStudio, BinaryTypeWindowsStudio64,
+		BinaryTypeWindowsStudioCJV, BinaryTypeWindowsStudio64CJV,
+		BinaryTypeMacStudio, BinaryTypeMacStudioCJV, BinaryTypeMacStudioArm64:
 		return "Studio"
 	default:
 		return "ShortenedBinaryType(" + string(bt) + ")"
 	}
 }
 
+// Platform returns the operating system of the BinaryType, either
+// "Windows" or "Mac".
+func (bt BinaryType) Platform() string {
+	switch bt {
+	case BinaryTypeWindowsPlayer, BinaryTypeWindowsStudio, BinaryTypeWindowsStudio64,
+		BinaryTypeWindowsStudioCJV, BinaryTypeWindowsStudio64CJV:
+		return "Windows"
+	case BinaryTypeMacPlayer, BinaryTypeMacStudio, BinaryTypeMacStudioCJV,
+		BinaryTypeMacPlayerArm64, BinaryTypeMacStudioArm64:
+		return "Mac"
+	default:
+		return "PlatformBinaryType(" + string(bt) + ")"
+	}
+}
+
+// Arch returns the processor architecture of the BinaryType, as named by
+// GOARCH. Mac deployments of non-arm64 binary types are universal, and
+// are considered as amd64.
+func (bt BinaryType) Arch() string {
+	switch bt {
+	case BinaryTypeWindowsStudio:
+		return "386"
+	case BinaryTypeMacPlayerArm64, BinaryTypeMacStudioArm64:
+		return "arm64"
+	default:
+		return "amd64"
+	}
+}
+
+// CJV reports whether the BinaryType is of the Chinese joint-venture
+// distribution of Roblox.
+func (bt BinaryType) CJV() bool {
+	return bt == BinaryTypeWindowsStudioCJV || bt == BinaryTypeWindowsStudio64CJV ||
+		bt == BinaryTypeMacStudioCJV
+}
+
+// Application returns the name of the client settings application of
+// the BinaryType.
+func (bt BinaryType) Application() string {
+	switch bt.Platform() + bt.Short() {
+	case "WindowsPlayer":
+		return "PCDesktopClient"
+	case "WindowsStudio":
+		return "PCStudioApp"
+	case "MacPlayer":
+		return "MacDesktopClient"
+	case "MacStudio":
+		return "MacStudioApp"
+	default:
+		return "ApplicationBinaryType(" + string(bt) + ")"
+	}
+}
+
 // ClientVersion implements the ClientVersionResponse API model.
 type ClientVersion struct {
 	Version      string `json:"version"`
@@ -44,6 +109,82 @@ func (cv ClientVersion) String() string {
 	return cv.Version + " (" + cv.GUID + ")"
 }
 
+// Number returns the parsed Version.
+func (cv ClientVersion) Number() (ClientVersionNumber, error) {
+	return ParseClientVersionNumber(cv.Version)
+}
+
+// ClientVersionNumber represents a parsed Roblox client version, such
+// as 0.600.0.6000521.
+type ClientVersionNumber struct {
+	Major int
+	Minor int
+	Patch int
+	Build int
+}
+
+// ParseClientVersionNumber parses the named version, either in the
+// dot-separated form "0.600.0.6000521", or in the comma-separated form
+// "0, 600, 0, 6000521" used by file versions.
+func ParseClientVersionNumber(s string) (ClientVersionNumber, error) {
+	sep := "."
+	if strings.Contains(s, ",") {
+		sep = ","
+	}
+
+	parts := strings.Split(s, sep)
+	if len(parts) != 4 {
+		return ClientVersionNumber{}, fmt.Errorf("client version %q: expected 4 components", s)
+	}
+
+	var n [4]int
+	for i, p := range parts {
+		v, err := strconv.Atoi(strings.TrimSpace(p))
+		if err != nil || v < 0 {
+			return ClientVersionNumber{}, fmt.Errorf("client version %q: invalid component %q", s, p)
+		}
+		n[i] = v
+	}
+
+	return ClientVersionNumber{n[0], n[1], n[2], n[3]}, nil
+}
+
+// String implements the Stringer interface.
+func (n ClientVersionNumber) String() string {
+	return fmt.Sprintf("%d.%d.%d.%d", n.Major, n.Minor, n.Patch, n.Build)
+}
+
+// IsZero reports whether the version is unset.
+func (n ClientVersionNumber) IsZero() bool {
+	return n == ClientVersionNumber{}
+}
+
+// Compare returns -1 if n is older than o, 1 if n is newer than o,
+// and 0 if they are equal.
+func (n ClientVersionNumber) Compare(o ClientVersionNumber) int {
+	return cmp.Or(
+		cmp.Compare(n.Major, o.Major),
+		cmp.Compare(n.Minor, o.Minor),
+		cmp.Compare(n.Patch, o.Patch),
+		cmp.Compare(n.Build, o.Build),
+	)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (n ClientVersionNumber) MarshalText() ([]byte, error) {
+	return []byte(n.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (n *ClientVersionNumber) UnmarshalText(text []byte) error {
+	v, err := ParseClientVersionNumber(string(text))
+	if err != nil {
+		return err
+	}
+	*n = v
+	return nil
+}
+
 // AssignmentType represents how the user was bound to a channel.
 type AssignmentType int
 
@@ -63,22 +204,54 @@ type UserChannel struct {
 	Token      string         `json:"token,omitempty"`
 }
 
+// Private reports whether the assignment binds the user to a private
+// channel, which requires the channel's token.
+func (at AssignmentType) Private() bool {
+	return at == AssignmentTypeBoundToPrivateChannel ||
+		at == AssignmentTypeOptedInToBetaProgramWithPrivateChannel
+}
+
 // GetClientVersion gets the client version information for the named
 // BinaryType and deployment channel.
 func (c *ClientSettingsServiceV2) GetClientVersion(bt BinaryType, channel string) (*ClientVersion, error) {
+	cv, _, err := c.getClientVersion(bt, channel, "")
+	return cv, err
+}
+
+// getClientVersion is GetClientVersion with the token for private channels.
+// getClientVersion returns the client version along with the response of
+// the request, which is available even if an API error was returned.
+func (c *ClientSettingsServiceV2) getClientVersion(bt BinaryType, channel, token string) (*ClientVersion, *http.Response, error) {
 	var cv ClientVersion
 
+	req, err := c.newClientVersionRequest(bt, channel, token)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp, err := c.Client.Do(req, &cv)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &cv, resp, nil
+}
+
+func (c *ClientSettingsServiceV2) newClientVersionRequest(bt BinaryType, channel, token string) (*http.Request, error) {
 	path := path("v2/client-version/%s", nil, bt)
 	if channel != "" {
 		path += "/channel/" + channel
 	}
 
-	err := c.Client.Execute("GET", "clientsettings", path, nil, &cv)
+	req, err := c.Client.NewRequest("GET", "clientsettings", path, nil)
 	if err != nil {
 		return nil, err
 	}
+	if token != "" {
+		req.Header.Set("Roblox-Channel-Token", token)
+	}
 
-	return &cv, nil
+	return req, nil
 }
 
 // GetUserChannel returns the channel name for the currently logged in
@@ -100,3 +273,23 @@ func (c *ClientSettingsServiceV2) GetUserChannel(bt *BinaryType) (*UserChannel,
 
 	return &uc, nil
 }
+
+// GetApplicationSettings returns the fast flags served for the BinaryType's
+// application on the named deployment channel.
+func (c *ClientSettingsServiceV2) GetApplicationSettings(bt BinaryType, channel string) (ApplicationSettings, error) {
+	asr := struct {
+		Settings ApplicationSettings `json:"applicationSettings"`
+	}{}
+
+	path := path("v2/settings/application/%s", nil, bt.Application())
+	if !IsLiveChannel(channel) {
+		path += "/bucket/" + channel
+	}
+
+	err := c.Client.Execute("GET", "clientsettingscdn", path, nil, &asr)
+	if err != nil {
+		return nil, err
+	}
+
+	return asr.Settings, nil
+}
@@ -0,0 +1,94 @@
+package rbxweb
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestParseClientVersionNumber(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    ClientVersionNumber
+		wantErr bool
+	}{
+		{in: "0.600.0.6000521", want: ClientVersionNumber{0, 600, 0, 6000521}},
+		{in: "0, 655, 0, 6550773", want: ClientVersionNumber{0, 655, 0, 6550773}},
+		{in: "0,655,0,6550773", want: ClientVersionNumber{0, 655, 0, 6550773}},
+		{in: "1.0.0.0", want: ClientVersionNumber{1, 0, 0, 0}},
+		{in: "", wantErr: true},
+		{in: "0.600.0", wantErr: true},
+		{in: "0.600.0.", wantErr: true},
+		{in: "0.600.0.6000521.1", wantErr: true},
+		{in: "0, 600, 0", wantErr: true},
+		{in: "0.600.0.-1", wantErr: true},
+		{in: "0.600.x.6000521", wantErr: true},
+		{in: "version-0123456789abcdef", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := ParseClientVersionNumber(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, want error %t", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("version = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClientVersionNumberCompare(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"0.600.0.6000521", "0.600.0.6000521", 0},
+		{"0.600.0.6000521", "0.601.0.6010400", -1},
+		{"0.601.0.6010400", "0.600.0.6000521", 1},
+		{"0.600.1.0", "0.600.0.9999999", 1},
+		{"1.0.0.0", "0.999.9.9999999", 1},
+		{"0.600.0.6000520", "0.600.0.6000521", -1},
+		// Components are compared numerically
+		{"0.99.0.0", "0.100.0.0", -1},
+	}
+
+	for _, tt := range tests {
+		a, err := ParseClientVersionNumber(tt.a)
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, err := ParseClientVersionNumber(tt.b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if got := a.Compare(b); got != tt.want {
+			t.Errorf("%s.Compare(%s) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestClientVersionNumberText(t *testing.T) {
+	v := ClientVersionNumber{0, 600, 0, 6000521}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != `"0.600.0.6000521"` {
+		t.Errorf("marshalled = %s, want %q", b, "0.600.0.6000521")
+	}
+
+	var got ClientVersionNumber
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatal(err)
+	}
+	if got != v {
+		t.Errorf("unmarshalled = %v, want %v", got, v)
+	}
+
+	if err := json.Unmarshal([]byte(`"0.600"`), &got); err == nil {
+		t.Error("expected error for truncated version")
+	}
+}
@@ -0,0 +1,363 @@
+package rbxweb
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CloudServiceV2 partially handles the 'cloud/v2' Roblox Open Cloud API.
+//
+// Requests require an API key with the appropriate permissions for each
+// resource to be set in the Client.
+type CloudServiceV2 service
+
+// UniverseVisibility represents who is able to join a Universe.
+type UniverseVisibility string
+
+const (
+	UniverseVisibilityPublic  UniverseVisibility = "PUBLIC"
+	UniverseVisibilityPrivate UniverseVisibility = "PRIVATE"
+)
+
+// Universe implements the Universe Open Cloud resource.
+type Universe struct {
+	Path                    string             `json:"path"`
+	CreateTime              string             `json:"createTime"`
+	UpdateTime              string             `json:"updateTime"`
+	DisplayName             string             `json:"displayName"`
+	Description             string             `json:"description"`
+	User                    string             `json:"user,omitempty"`  // users/{user_id}
+	Group                   string             `json:"group,omitempty"` // groups/{group_id}
+	Visibility              UniverseVisibility `json:"visibility"`
+	VoiceChatEnabled        bool               `json:"voiceChatEnabled"`
+	AgeRating               string             `json:"ageRating"`
+	PrivateServerPriceRobux *int64             `json:"privateServerPriceRobux,omitempty"` // Private servers are disabled if nil
+	DesktopEnabled          bool               `json:"desktopEnabled"`
+	MobileEnabled           bool               `json:"mobileEnabled"`
+	TabletEnabled           bool               `json:"tabletEnabled"`
+	ConsoleEnabled          bool               `json:"consoleEnabled"`
+	VREnabled               bool               `json:"vrEnabled"`
+}
+
+// Place implements the Place Open Cloud resource.
+type Place struct {
+	Path        string `json:"path"`
+	CreateTime  string `json:"createTime"`
+	UpdateTime  string `json:"updateTime"`
+	DisplayName string `json:"displayName"`
+	Description string `json:"description"`
+	ServerSize  int32  `json:"serverSize"`
+}
+
+// UniverseUpdate provides the Universe fields to be updated; only non-nil
+// fields are updated.
+type UniverseUpdate struct {
+	DisplayName      *string             `json:"displayName,omitempty"`
+	Description      *string             `json:"description,omitempty"`
+	Visibility       *UniverseVisibility `json:"visibility,omitempty"`
+	VoiceChatEnabled *bool               `json:"voiceChatEnabled,omitempty"`
+	// PrivateServerPriceRobux enables private servers with the given price,
+	// which may be 0 for free private servers.
+	PrivateServerPriceRobux *int64 `json:"privateServerPriceRobux,omitempty"`
+	// DisablePrivateServers disables private servers, and takes precedence
+	// over PrivateServerPriceRobux.
+	DisablePrivateServers bool `json:"-"`
+}
+
+func (uu *UniverseUpdate) mask() []string {
+	var m []string
+	if uu.DisplayName != nil {
+		m = append(m, "displayName")
+	}
+	if uu.Description != nil {
+		m = append(m, "description")
+	}
+	if uu.Visibility != nil {
+		m = append(m, "visibility")
+	}
+	if uu.VoiceChatEnabled != nil {
+		m = append(m, "voiceChatEnabled")
+	}
+	if uu.PrivateServerPriceRobux != nil || uu.DisablePrivateServers {
+		m = append(m, "privateServerPriceRobux")
+	}
+	return m
+}
+
+// PlaceUpdate provides the Place fields to be updated; only non-nil
+// fields are updated.
+type PlaceUpdate struct {
+	DisplayName *string `json:"displayName,omitempty"`
+	Description *string `json:"description,omitempty"`
+	ServerSize  *int32  `json:"serverSize,omitempty"`
+}
+
+func (pu *PlaceUpdate) mask() []string {
+	var m []string
+	if pu.DisplayName != nil {
+		m = append(m, "displayName")
+	}
+	if pu.Description != nil {
+		m = append(m, "description")
+	}
+	if pu.ServerSize != nil {
+		m = append(m, "serverSize")
+	}
+	return m
+}
+
+// GetUniverse returns the Universe of the given Universe ID.
+func (c *CloudServiceV2) GetUniverse(uid UniverseID) (*Universe, error) {
+	var u Universe
+
+	err := c.Client.Execute("GET", "apis", path("cloud/v2/universes/%d", nil, uid), nil, &u)
+	if err != nil {
+		return nil, err
+	}
+
+	return &u, nil
+}
+
+// UpdateUniverse updates the given Universe ID with the non-nil fields of
+// the UniverseUpdate, and returns the updated Universe.
+func (c *CloudServiceV2) UpdateUniverse(uid UniverseID, uu *UniverseUpdate) (*Universe, error) {
+	var u Universe
+
+	body := *uu
+	if uu.DisablePrivateServers {
+		body.PrivateServerPriceRobux = nil
+	}
+
+	q := url.Values{"updateMask": {strings.Join(uu.mask(), ",")}}
+	err := c.Client.Execute("PATCH", "apis", path("cloud/v2/universes/%d", q, uid), &body, &u)
+	if err != nil {
+		return nil, err
+	}
+
+	return &u, nil
+}
+
+// RestartServers restarts all servers of the given Universe ID running
+// an outdated version of the universe's places.
+func (c *CloudServiceV2) RestartServers(uid UniverseID) error {
+	return c.Client.Execute("POST", "apis",
+		path("cloud/v2/universes/%d:restartServers", nil, uid), struct{}{}, nil)
+}
+
+// GetPlace returns the Place of the given Place ID in the Universe ID.
+func (c *CloudServiceV2) GetPlace(uid UniverseID, pid PlaceID) (*Place, error) {
+	var p Place
+
+	err := c.Client.Execute("GET", "apis",
+		path("cloud/v2/universes/%d/places/%d", nil, uid, pid), nil, &p)
+	if err != nil {
+		return nil, err
+	}
+
+	return &p, nil
+}
+
+// UpdatePlace updates the given Place ID in the Universe ID with the non-nil
+// fields of the PlaceUpdate, and returns the updated Place.
+func (c *CloudServiceV2) UpdatePlace(uid UniverseID, pid PlaceID, pu *PlaceUpdate) (*Place, error) {
+	var p Place
+
+	q := url.Values{"updateMask": {strings.Join(pu.mask(), ",")}}
+	err := c.Client.Execute("PATCH", "apis",
+		path("cloud/v2/universes/%d/places/%d", q, uid, pid), pu, &p)
+	if err != nil {
+		return nil, err
+	}
+
+	return &p, nil
+}
+
+// Duration represents a duration in the protobuf JSON format of the Open
+// Cloud APIs, such as "3600s".
+type Duration time.Duration
+
+// MarshalJSON implements the json.Marshaler interface.
+func (d Duration) MarshalJSON() ([]byte, error) {
+	s := strconv.FormatFloat(time.Duration(d).Seconds(), 'f', -1, 64) + "s"
+	return json.Marshal(s)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	td, err := time.ParseDuration(s)
+	if err != nil {
+		return fmt.Errorf("duration %q: %w", s, err)
+	}
+	*d = Duration(td)
+	return nil
+}
+
+// GameJoinRestriction implements the GameJoinRestriction Open Cloud model.
+type GameJoinRestriction struct {
+	Active bool `json:"active"`
+	// Duration is the length of the restriction; the restriction is
+	// permanent if nil.
+	Duration           *Duration `json:"duration,omitempty"`
+	StartTime          string    `json:"startTime,omitempty"`
+	PrivateReason      string    `json:"privateReason,omitempty"` // Shown only to the universe's moderators
+	DisplayReason      string    `json:"displayReason,omitempty"` // Shown to the restricted user
+	ExcludeAltAccounts bool      `json:"excludeAltAccounts"`
+	Inherited          bool      `json:"inherited,omitempty"` // Inherited from the universe, read-only
+}
+
+// UserRestriction implements the UserRestriction Open Cloud resource.
+type UserRestriction struct {
+	Path                string              `json:"path"`
+	UpdateTime          string              `json:"updateTime"`
+	User                string              `json:"user"` // users/{user_id}
+	GameJoinRestriction GameJoinRestriction `json:"gameJoinRestriction"`
+}
+
+// UserRestrictionLog implements the UserRestrictionLog Open Cloud model.
+type UserRestrictionLog struct {
+	User      string `json:"user"`            // users/{user_id}
+	Place     string `json:"place,omitempty"` // places/{place_id}, if restricted by place
+	Moderator struct {
+		RobloxUser       string    `json:"robloxUser,omitempty"` // users/{user_id}
+		GameServerScript *struct{} `json:"gameServerScript,omitempty"`
+	} `json:"moderator"`
+	CreateTime         string    `json:"createTime"`
+	Active             bool      `json:"active"`
+	StartTime          string    `json:"startTime"`
+	Duration           *Duration `json:"duration,omitempty"`
+	PrivateReason      string    `json:"privateReason"`
+	DisplayReason      string    `json:"displayReason"`
+	ExcludeAltAccounts bool      `json:"excludeAltAccounts"`
+}
+
+// userRestrictionPath returns the path of the user restriction of the given
+// User ID, in the Place ID if non-zero, otherwise the Universe ID, with the
+// query values (if any).
+func userRestrictionPath(uid UniverseID, pid PlaceID, user UserID, query url.Values) string {
+	if pid != 0 {
+		return path("cloud/v2/universes/%d/places/%d/user-restrictions/%d", query, uid, pid, user)
+	}
+	return path("cloud/v2/universes/%d/user-restrictions/%d", query, uid, user)
+}
+
+// GetUserRestriction returns the restriction of the given User ID in the
+// Universe ID, or in the Place ID of the universe if the Place ID is non-zero.
+func (c *CloudServiceV2) GetUserRestriction(uid UniverseID, pid PlaceID, user UserID) (*UserRestriction, error) {
+	var ur UserRestriction
+
+	err := c.Client.Execute("GET", "apis", userRestrictionPath(uid, pid, user, nil), nil, &ur)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ur, nil
+}
+
+// UpdateUserRestriction restricts the given User ID from joining the Universe
+// ID, or the Place ID of the universe if the Place ID is non-zero, and returns
+// the updated UserRestriction. To lift a restriction, set Active to false.
+func (c *CloudServiceV2) UpdateUserRestriction(uid UniverseID, pid PlaceID, user UserID, gjr *GameJoinRestriction) (*UserRestriction, error) {
+	var ur UserRestriction
+
+	req := struct {
+		GameJoinRestriction *GameJoinRestriction `json:"gameJoinRestriction"`
+	}{gjr}
+
+	q := url.Values{"updateMask": {"gameJoinRestriction"}}
+	err := c.Client.Execute("PATCH", "apis", userRestrictionPath(uid, pid, user, q), req, &ur)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ur, nil
+}
+
+// ListUserRestrictions returns a page of user restrictions in the Universe ID,
+// or in the Place ID of the universe if the Place ID is non-zero, and the token
+// of the next page, if any. The page token is optional.
+func (c *CloudServiceV2) ListUserRestrictions(uid UniverseID, pid PlaceID, pageSize int, pageToken string) ([]UserRestriction, string, error) {
+	urr := struct {
+		Restrictions []UserRestriction `json:"userRestrictions"`
+		Next         string            `json:"nextPageToken"`
+	}{}
+
+	p := path("cloud/v2/universes/%d/user-restrictions", cloudPageQuery(pageSize, pageToken), uid)
+	if pid != 0 {
+		p = path("cloud/v2/universes/%d/places/%d/user-restrictions", cloudPageQuery(pageSize, pageToken), uid, pid)
+	}
+
+	err := c.Client.Execute("GET", "apis", p, nil, &urr)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return urr.Restrictions, urr.Next, nil
+}
+
+// ListUserRestrictionLogs returns a page of the changes made to user restrictions
+// in the Universe ID, and the token of the next page, if any. The page token
+// and filter are optional; the filter may be used to limit logs to a user or
+// place, such as `user == 'users/123'`.
+func (c *CloudServiceV2) ListUserRestrictionLogs(uid UniverseID, filter string, pageSize int, pageToken string) ([]UserRestrictionLog, string, error) {
+	lr := struct {
+		Logs []UserRestrictionLog `json:"logs"`
+		Next string               `json:"nextPageToken"`
+	}{}
+
+	q := cloudPageQuery(pageSize, pageToken)
+	if filter != "" {
+		q.Set("filter", filter)
+	}
+
+	err := c.Client.Execute("GET", "apis",
+		path("cloud/v2/universes/%d/user-restrictions:listLogs", q, uid), nil, &lr)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return lr.Logs, lr.Next, nil
+}
+
+// UserThumbnail implements the GenerateUserThumbnailResponse Open Cloud model.
+type UserThumbnail struct {
+	ImageURI string `json:"imageUri"`
+}
+
+// GenerateUserThumbnail requests a headshot thumbnail of the given User ID
+// to be generated, of the given size in pixels and thumbnail format, and
+// returns the operation of its generation. The size and format are optional.
+func (c *CloudServiceV2) GenerateUserThumbnail(user UserID, size int, format ThumbnailFormat, rectangular bool) (*Operation[UserThumbnail], error) {
+	q := url.Values{"shape": {"ROUND"}}
+	if rectangular {
+		q.Set("shape", "SQUARE")
+	}
+	if size != 0 {
+		q.Set("size", strconv.Itoa(size))
+	}
+	if format != "" {
+		q.Set("format", strings.ToUpper(string(format)))
+	}
+
+	return executeOperation[UserThumbnail](c.Client, "GET", "cloud/v2/",
+		path("users/%d:generateThumbnail", q, user), nil)
+}
+
+func cloudPageQuery(pageSize int, pageToken string) url.Values {
+	q := url.Values{}
+	if pageSize > 0 {
+		q.Set("maxPageSize", strconv.Itoa(pageSize))
+	}
+	if pageToken != "" {
+		q.Set("pageToken", pageToken)
+	}
+	return q
+}
@@ -0,0 +1,55 @@
+package rbxweb
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestUpdateUserRestriction(t *testing.T) {
+	tests := []struct {
+		name string
+		pid  PlaceID
+		want string
+	}{
+		{"universe", 0, "/cloud/v2/universes/1/user-restrictions/156?updateMask=gameJoinRestriction"},
+		{"place", 1818, "/cloud/v2/universes/1/places/1818/user-restrictions/156?updateMask=gameJoinRestriction"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var uri string
+			var body map[string]map[string]any
+			c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				uri = r.Method + " " + r.URL.RequestURI()
+				if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+					t.Error(err)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				io.WriteString(w, `{"path":"universes/1/user-restrictions/156","user":"users/156",`+
+					`"gameJoinRestriction":{"active":true,"duration":"3600s"}}`)
+			}))
+
+			d := Duration(time.Hour)
+			ur, err := c.CloudV2.UpdateUserRestriction(1, tt.pid, 156, &GameJoinRestriction{
+				Active:   true,
+				Duration: &d,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if want := "PATCH " + tt.want; uri != want {
+				t.Errorf("request = %s, want %s", uri, want)
+			}
+			if gjr := body["gameJoinRestriction"]; gjr["active"] != true || gjr["duration"] != "3600s" {
+				t.Errorf("body = %v", body)
+			}
+			if ur.GameJoinRestriction.Duration == nil || *ur.GameJoinRestriction.Duration != d {
+				t.Errorf("duration = %v, want %v", ur.GameJoinRestriction.Duration, d)
+			}
+		})
+	}
+}
@@ -0,0 +1,148 @@
+package rbxweb
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// DeployRecord represents a deployment entry of DeployHistory.txt.
+type DeployRecord struct {
+	Type BinaryType
+	GUID string
+	// Time is the time of the deployment. The history does not specify
+	// a time zone, and is parsed as UTC.
+	Time    time.Time
+	Version ClientVersionNumber // zero for older deployments
+	GitHash string              // empty for older deployments
+}
+
+// deployHistoryTypes maps the binary type names used in the deploy
+// history to a BinaryType.
+var deployHistoryTypes = map[string]BinaryType{
+	"WindowsPlayer": BinaryTypeWindowsPlayer,
+	"Studio":        BinaryTypeWindowsStudio,
+	"Studio64":      BinaryTypeWindowsStudio64,
+	"MacPlayer":     BinaryTypeMacPlayer,
+	"MacStudio":     BinaryTypeMacStudio,
+}
+
+const deployHistoryTime = "1/2/2006 3:04:05 PM"
+
+var deployHistoryLine = regexp.MustCompile(
+	`^New (\S+) (version-[0-9a-fA-F]+) at (\d+/\d+/\d+ \d+:\d+:\d+ [AP]M)` +
+		`(?:, file version: ([\d, ]+?))?(?:, git hash: ([0-9a-fA-F]+))? ?\.\.\.`)
+
+// ParseDeployHistory parses the DeployHistory.txt format read from r, in
+// which each deployment is represented by a line such as:
+//
+//	New WindowsPlayer version-0123456789abcdef at 1/2/2024 3:04:05 PM, file version: 0, 600, 0, 6000521, git hash: ... ...Done!
+//
+// Lines that do not represent a deployment are ignored. Binary types that
+// are not known are used as-is.
+func ParseDeployHistory(r io.Reader) ([]DeployRecord, error) {
+	var records []DeployRecord
+	s := bufio.NewScanner(r)
+
+	for s.Scan() {
+		m := deployHistoryLine.FindStringSubmatch(strings.TrimSpace(s.Text()))
+		if m == nil {
+			continue
+		}
+
+		t, err := time.Parse(deployHistoryTime, m[3])
+		if err != nil {
+			return nil, fmt.Errorf("deployment %s: %w", m[2], err)
+		}
+
+		bt, ok := deployHistoryTypes[m[1]]
+		if !ok {
+			bt = BinaryType(m[1])
+		}
+
+		var v ClientVersionNumber
+		if m[4] != "" {
+			v, err = ParseClientVersionNumber(m[4])
+			if err != nil {
+				return nil, fmt.Errorf("deployment %s: %w", m[2], err)
+			}
+		}
+
+		records = append(records, DeployRecord{
+			Type:    bt,
+			GUID:    m[2],
+			Time:    t,
+			Version: v,
+			GitHash: m[5],
+		})
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
+
+// deployHistoryPath returns the path of the deploy history that contains
+// deployments of the BinaryType.
+func deployHistoryPath(bt BinaryType) string {
+	if bt.Platform() == "Mac" {
+		return "mac/DeployHistory.txt"
+	}
+	return "DeployHistory.txt"
+}
+
+// GetDeployHistory returns all deployment records of the deploy history
+// containing deployments of the BinaryType.
+func (ds *DeploymentService) GetDeployHistory(ctx context.Context, bt BinaryType) ([]DeployRecord, error) {
+	resp, err := ds.get(ctx, deployHistoryPath(bt), 0)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return ParseDeployHistory(resp.Body)
+}
+
+// ListVersions returns the deployment records of the BinaryType, in the
+// order they were deployed.
+func (ds *DeploymentService) ListVersions(ctx context.Context, bt BinaryType) ([]DeployRecord, error) {
+	records, err := ds.GetDeployHistory(ctx, bt)
+	if err != nil {
+		return nil, err
+	}
+
+	var filtered []DeployRecord
+	for _, r := range records {
+		if r.Type == bt {
+			filtered = append(filtered, r)
+		}
+	}
+
+	return filtered, nil
+}
+
+// FindVersion returns the most recent deployment record of the named
+// deployment GUID, searching the Windows and Mac deploy histories.
+//
+// If none are found, nil will be returned.
+func (ds *DeploymentService) FindVersion(ctx context.Context, guid string) (*DeployRecord, error) {
+	for _, bt := range []BinaryType{BinaryTypeWindowsPlayer, BinaryTypeMacPlayer} {
+		records, err := ds.GetDeployHistory(ctx, bt)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := len(records) - 1; i >= 0; i-- {
+			if records[i].GUID == guid {
+				return &records[i], nil
+			}
+		}
+	}
+
+	return nil, nil
+}
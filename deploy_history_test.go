@@ -0,0 +1,83 @@
+package rbxweb
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseDeployHistory(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      string
+		want    []DeployRecord
+		wantErr bool
+	}{
+		{
+			name: "deployments",
+			in: "New Studio version-1f2e3d4c5b6a7980 at 9/4/2013 6:07:43 PM... Done!\r\n" +
+				"New WindowsPlayer version-0123456789abcdef at 1/2/2024 3:04:05 PM, file version: 0, 600, 0, 6000521, git hash: 6d4f1a2b3c ...Done!\r\n" +
+				"New Studio64 version-fedcba9876543210 at 12/31/2024 11:59:59 AM, file version: 0, 655, 0, 6550773 ...Done!\r\n",
+			want: []DeployRecord{
+				{
+					Type: BinaryTypeWindowsStudio,
+					GUID: "version-1f2e3d4c5b6a7980",
+					Time: time.Date(2013, 9, 4, 18, 7, 43, 0, time.UTC),
+				},
+				{
+					Type:    BinaryTypeWindowsPlayer,
+					GUID:    "version-0123456789abcdef",
+					Time:    time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC),
+					Version: ClientVersionNumber{0, 600, 0, 6000521},
+					GitHash: "6d4f1a2b3c",
+				},
+				{
+					Type:    BinaryTypeWindowsStudio64,
+					GUID:    "version-fedcba9876543210",
+					Time:    time.Date(2024, 12, 31, 11, 59, 59, 0, time.UTC),
+					Version: ClientVersionNumber{0, 655, 0, 6550773},
+				},
+			},
+		},
+		{
+			name: "unknown binary type",
+			in:   "New Client version-abc123 at 3/4/2008 1:02:03 AM... Done!\n",
+			want: []DeployRecord{{
+				Type: BinaryType("Client"),
+				GUID: "version-abc123",
+				Time: time.Date(2008, 3, 4, 1, 2, 3, 0, time.UTC),
+			}},
+		},
+		{
+			name: "ignored lines",
+			in: "\n" +
+				"Deployment log\n" +
+				"New WindowsPlayer version-0123456789abcdef at 1/2/2024\n" +
+				"New WindowsPlayer version-0123456789abcdef at 1/2/2024 3:04:05 PM, file ver\n",
+		},
+		{name: "empty", in: ""},
+		{
+			name:    "invalid time",
+			in:      "New WindowsPlayer version-0123456789abcdef at 13/45/2024 3:04:05 PM... Done!\n",
+			wantErr: true,
+		},
+		{
+			name:    "truncated file version",
+			in:      "New WindowsPlayer version-0123456789abcdef at 1/2/2024 3:04:05 PM, file version: 0, 600 ...Done!\n",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			records, err := ParseDeployHistory(strings.NewReader(tt.in))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, want error %t", err, tt.wantErr)
+			}
+			if !slices.Equal(records, tt.want) {
+				t.Errorf("records = %v, want %v", records, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,485 @@
+package rbxweb
+
+import (
+	"bufio"
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// DefaultMirror is the default deployment setup mirror.
+const DefaultMirror = "https://setup.rbxcdn.com"
+
+// DefaultMirrors is the list of known deployment setup mirrors.
+var DefaultMirrors = []string{
+	DefaultMirror,
+	"https://roblox-setup.cachefly.net",
+	"https://s3.amazonaws.com/setup.roblox.com",
+}
+
+// DeploymentService handles retrieving Roblox deployments from the
+// deployment setup mirror of the Client.
+type DeploymentService service
+
+// ErrChecksumMismatch is returned when the checksum of a downloaded file does
+// not match its expected checksum.
+var ErrChecksumMismatch = errors.New("checksum mismatch")
+
+// ChannelLive is the name of the default deployment channel.
+const ChannelLive = "LIVE"
+
+// Deployment represents a Roblox deployment of a BinaryType.
+type Deployment struct {
+	Type    BinaryType
+	Channel string // Empty or LIVE for the default channel
+	GUID    string // e.g. version-0123456789abcdef
+	Version string // e.g. 0.600.0.6000521, if known
+	Token   string // Token of the channel if private, only sent to the client settings API
+}
+
+// Package represents a package entry of a Deployment's package manifest.
+type Package struct {
+	Name     string // e.g. RobloxApp.zip
+	Checksum string // MD5 checksum of the package
+	ZipSize  int64  // Compressed size of the package
+	Size     int64  // Uncompressed size of the package
+}
+
+// IsLiveChannel reports whether the named channel refers to the default
+// deployment channel, which is named as either LIVE or production.
+func IsLiveChannel(channel string) bool {
+	return channel == "" ||
+		strings.EqualFold(channel, ChannelLive) ||
+		strings.EqualFold(channel, "production")
+}
+
+// Prefix returns the path prefix on the deployment setup mirror of the
+// files of the Deployment's channel and platform.
+func (d *Deployment) Prefix() string {
+	p := ""
+	if !IsLiveChannel(d.Channel) {
+		p += "channel/" + strings.ToLower(d.Channel) + "/"
+	}
+	if d.Type.Platform() == "Mac" {
+		p += "mac/"
+		if d.Type.Arch() == "arm64" {
+			p += "arm64/"
+		}
+	}
+	return p
+}
+
+// Path returns the path on the deployment setup mirror of the named file
+// of the Deployment.
+func (d *Deployment) Path(name string) string {
+	return d.Prefix() + d.GUID + "-" + name
+}
+
+// GetDeployment resolves the current deployment of the BinaryType on the
+// named channel.
+//
+// If the channel is empty, the channel of the currently logged in user is
+// used, along with its token if the user is bound to a private channel.
+// If the channel given does not exist, the deployment of the LIVE channel
+// is returned instead; other failures, such as an invalid channel token or
+// the API being unavailable, are returned as-is.
+func (ds *DeploymentService) GetDeployment(bt BinaryType, channel string) (*Deployment, error) {
+	d := &Deployment{Type: bt, Channel: channel}
+
+	// An explicit channel may still be the user's own private channel,
+	// only known if logged in.
+	if channel == "" || ds.Client.Security != "" {
+		uc, err := ds.Client.ClientSettingsV2.GetUserChannel(&bt)
+		if err != nil && channel == "" {
+			return nil, err
+		}
+		if err == nil && (channel == "" || strings.EqualFold(channel, uc.Channel)) {
+			d.Channel = uc.Channel
+			if uc.Assignment.Private() {
+				d.Token = uc.Token
+			}
+		}
+	}
+	if IsLiveChannel(d.Channel) {
+		d.Channel = ChannelLive
+	}
+
+	// The LIVE channel is requested without naming it
+	channel = d.Channel
+	if channel == ChannelLive {
+		channel = ""
+	}
+
+	cv, resp, err := ds.Client.ClientSettingsV2.getClientVersion(bt, channel, d.Token)
+	if err != nil && d.Channel != ChannelLive && channelUnavailable(resp, err) {
+		d.Channel = ChannelLive
+		d.Token = ""
+		cv, _, err = ds.Client.ClientSettingsV2.getClientVersion(bt, "", "")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	d.GUID = cv.GUID
+	d.Version = cv.Version
+	return d, nil
+}
+
+// channelUnavailable reports whether the failed client version request
+// was rejected for its channel not being available. Authorization failures,
+// such as an invalid channel token, rate limits and server failures are not
+// considered as such.
+func channelUnavailable(resp *http.Response, err error) bool {
+	if resp == nil || !isAPIError(err) {
+		return false
+	}
+
+	switch resp.StatusCode {
+	case http.StatusUnauthorized, http.StatusForbidden, http.StatusTooManyRequests:
+		return false
+	}
+	return resp.StatusCode >= http.StatusBadRequest && resp.StatusCode < http.StatusInternalServerError
+}
+
+// ParsePackageManifest parses the rbxPkgManifest format read from r,
+// consisting of a version header, followed by four lines for each
+// package: name, checksum, compressed size and uncompressed size. Package
+// names must be plain file names.
+func ParsePackageManifest(r io.Reader) ([]Package, error) {
+	s := bufio.NewScanner(r)
+
+	if !s.Scan() {
+		if err := s.Err(); err != nil {
+			return nil, err
+		}
+		return nil, errors.New("empty package manifest")
+	}
+	if v := strings.TrimSpace(s.Text()); v != "v0" {
+		return nil, fmt.Errorf("unhandled package manifest version %q", v)
+	}
+
+	var pkgs []Package
+	var lines [4]string
+	n := 0
+	for s.Scan() {
+		line := strings.TrimSpace(s.Text())
+		if line == "" && n == 0 {
+			continue
+		}
+		lines[n] = line
+		n++
+		if n < len(lines) {
+			continue
+		}
+		n = 0
+
+		if !validPackageName(lines[0]) {
+			return nil, fmt.Errorf("illegal package name %q", lines[0])
+		}
+
+		zipSize, err := strconv.ParseInt(lines[2], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("package %s: %w", lines[0], err)
+		}
+		size, err := strconv.ParseInt(lines[3], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("package %s: %w", lines[0], err)
+		}
+
+		pkgs = append(pkgs, Package{
+			Name:     lines[0],
+			Checksum: lines[1],
+			ZipSize:  zipSize,
+			Size:     size,
+		})
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	if n != 0 {
+		return nil, errors.New("truncated package manifest")
+	}
+
+	return pkgs, nil
+}
+
+// validPackageName reports whether the package name is a plain file name,
+// as package names are used as file names when downloading packages.
+func validPackageName(name string) bool {
+	return filepath.IsLocal(name) && !strings.ContainsAny(name, `/\`)
+}
+
+// get performs a GET request for the named path on the deployment setup
+// mirror, starting at the given byte offset if non-zero. If the request fails,
+// each alternative mirror of the Client is tried in order, and the error of
+// the primary mirror is returned if all fail.
+func (ds *DeploymentService) get(ctx context.Context, path string, offset int64) (*http.Response, error) {
+	resp, err := ds.getMirror(ctx, ds.Client.Mirror, path, offset)
+	if err == nil {
+		return resp, nil
+	}
+
+	for _, m := range ds.Client.Mirrors {
+		if m == ds.Client.Mirror {
+			continue
+		}
+		if ctx.Err() != nil {
+			break
+		}
+
+		resp, mErr := ds.getMirror(ctx, m, path, offset)
+		if mErr == nil {
+			return resp, nil
+		}
+	}
+
+	return nil, err
+}
+
+// getMirror performs a GET request for the named path on the mirror. The
+// security cookie, channel token and other API headers are not sent to the
+// mirror, as mirrors may be run by third parties.
+func (ds *DeploymentService) getMirror(ctx context.Context, mirror, path string, offset int64) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", mirror+"/"+path, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", userAgent)
+	if offset > 0 {
+		req.Header.Set("Range", "bytes="+strconv.FormatInt(offset, 10)+"-")
+	}
+
+	resp, err := ds.Client.Client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		resp.Body.Close()
+		return nil, &StatusError{StatusCode: resp.StatusCode}
+	}
+
+	return resp, nil
+}
+
+// FindMirror probes the Client's mirrors, and the current mirror, for their
+// availability, and sets the current mirror to the fastest available mirror.
+//
+// A mirror is considered available if it serves either version.txt or
+// versionQTStudio.
+func (ds *DeploymentService) FindMirror(ctx context.Context) (string, error) {
+	mirrors := ds.Client.Mirrors
+	if !slices.Contains(mirrors, ds.Client.Mirror) && ds.Client.Mirror != "" {
+		mirrors = append([]string{ds.Client.Mirror}, mirrors...)
+	}
+	if len(mirrors) == 0 {
+		return "", errors.New("no mirrors available")
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	found := make(chan string, len(mirrors))
+	errs := make(chan error, len(mirrors))
+	for _, m := range mirrors {
+		go func() {
+			if err := ds.probeMirror(ctx, m); err != nil {
+				errs <- fmt.Errorf("mirror %s: %w", m, err)
+				return
+			}
+			found <- m
+		}()
+	}
+
+	var mErrs []error
+	for range mirrors {
+		select {
+		case m := <-found:
+			ds.Client.Mirror = m
+			return m, nil
+		case err := <-errs:
+			mErrs = append(mErrs, err)
+		}
+	}
+
+	return "", errors.Join(mErrs...)
+}
+
+func (ds *DeploymentService) probeMirror(ctx context.Context, mirror string) error {
+	var err error
+	for _, name := range []string{"version.txt", "versionQTStudio"} {
+		var resp *http.Response
+		resp, err = ds.getMirror(ctx, mirror, name, 0)
+		if err == nil {
+			resp.Body.Close()
+			return nil
+		}
+	}
+	return err
+}
+
+// ListPackages returns the packages of the Deployment's package manifest.
+//
+// Mac deployments have no package manifest, and instead consist of a single
+// package of the application bundle, which has no checksum or sizes.
+func (ds *DeploymentService) ListPackages(ctx context.Context, d *Deployment) ([]Package, error) {
+	switch {
+	case d.Type.Platform() == "Mac" && d.Type.Short() == "Player":
+		return []Package{{Name: "RobloxPlayer.zip"}}, nil
+	case d.Type.Platform() == "Mac" && d.Type.Short() == "Studio":
+		return []Package{{Name: "RobloxStudioApp.zip"}}, nil
+	}
+
+	resp, err := ds.get(ctx, d.Path("rbxPkgManifest.txt"), 0)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return ParsePackageManifest(resp.Body)
+}
+
+// DownloadPackage downloads the package of the Deployment to the named file,
+// and verifies its checksum. If the file already contains a partial download
+// of the package, the download will be resumed; if it is already complete,
+// no download is performed.
+//
+// If the checksum does not match, the file is removed and an error wrapping
+// ErrChecksumMismatch is returned. Packages without a checksum are always
+// downloaded in full, and are not verified.
+func (ds *DeploymentService) DownloadPackage(ctx context.Context, d *Deployment, pkg Package, name string) error {
+	f, err := os.OpenFile(name, os.O_RDWR|os.O_CREATE, 0o644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	fi, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	offset := fi.Size()
+
+	if pkg.Checksum == "" {
+		offset = 0
+	} else if offset > 0 && offset >= pkg.ZipSize {
+		if err := verifyPackage(f, pkg); err == nil {
+			return nil
+		}
+		offset = 0
+	}
+
+	resp, err := ds.get(ctx, d.Path(pkg.Name), offset)
+	if err != nil {
+		return fmt.Errorf("package %s: %w", pkg.Name, err)
+	}
+	defer resp.Body.Close()
+
+	// The mirror may not support ranges, returning the entire file
+	if resp.StatusCode != http.StatusPartialContent {
+		offset = 0
+	}
+	if err := f.Truncate(offset); err != nil {
+		return err
+	}
+	if _, err := f.Seek(offset, io.SeekStart); err != nil {
+		return err
+	}
+
+	if _, err := io.Copy(f, resp.Body); err != nil {
+		return fmt.Errorf("package %s: %w", pkg.Name, err)
+	}
+
+	if err := verifyPackage(f, pkg); err != nil {
+		f.Close()
+		os.Remove(name)
+		return err
+	}
+
+	return nil
+}
+
+// DownloadPackages downloads the packages of the Deployment concurrently into
+// the named directory, with each package named as its package name. At most
+// concurrency downloads are performed at once, or 4 if it is not positive.
+// Packages with names that are not plain file names are rejected.
+//
+// See DownloadPackage for more details.
+func (ds *DeploymentService) DownloadPackages(ctx context.Context, d *Deployment, pkgs []Package, dir string, concurrency int) error {
+	if concurrency <= 0 {
+		concurrency = 4
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	var once sync.Once
+	var firstErr error
+	sem := make(chan struct{}, concurrency)
+
+	for _, pkg := range pkgs {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			err := fmt.Errorf("illegal package name %q", pkg.Name)
+			if validPackageName(pkg.Name) {
+				err = ds.DownloadPackage(ctx, d, pkg, filepath.Join(dir, pkg.Name))
+			}
+			if err != nil {
+				once.Do(func() {
+					firstErr = err
+					cancel()
+				})
+			}
+		}()
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return firstErr
+	}
+	return ctx.Err()
+}
+
+// verifyPackage verifies the MD5 checksum of the package file f.
+func verifyPackage(f *os.File, pkg Package) error {
+	if pkg.Checksum == "" {
+		return nil
+	}
+
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	h := md5.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return err
+	}
+
+	if sum := hex.EncodeToString(h.Sum(nil)); !strings.EqualFold(sum, pkg.Checksum) {
+		return fmt.Errorf("package %s: %w: got %s, want %s",
+			pkg.Name, ErrChecksumMismatch, sum, pkg.Checksum)
+	}
+
+	return nil
+}
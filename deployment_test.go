@@ -0,0 +1,422 @@
+package rbxweb
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+// newTestMirror returns the URL of a deployment setup mirror serving
+// files with the given status after the given delay.
+func newTestMirror(t *testing.T, status int, delay time.Duration) string {
+	t.Helper()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-time.After(delay):
+		case <-r.Context().Done():
+			return
+		}
+		w.WriteHeader(status)
+		io.WriteString(w, r.URL.Path)
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv.URL
+}
+
+// newDeadMirror returns the URL of a deployment setup mirror that
+// refuses connections.
+func newDeadMirror(t *testing.T) string {
+	t.Helper()
+
+	srv := httptest.NewServer(http.NotFoundHandler())
+	srv.Close()
+
+	return srv.URL
+}
+
+func TestFindMirror(t *testing.T) {
+	slow := newTestMirror(t, http.StatusOK, 200*time.Millisecond)
+	fast := newTestMirror(t, http.StatusOK, 0)
+	broken := newTestMirror(t, http.StatusInternalServerError, 0)
+	dead := newDeadMirror(t)
+
+	c := NewClient()
+	c.Mirror = dead
+	c.Mirrors = []string{slow, broken, fast}
+
+	m, err := c.Deployment.FindMirror(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m != fast || c.Mirror != fast {
+		t.Errorf("mirror = %s (client %s), want %s", m, c.Mirror, fast)
+	}
+}
+
+func TestFindMirrorUnavailable(t *testing.T) {
+	c := NewClient()
+	c.Mirror = newDeadMirror(t)
+	c.Mirrors = []string{newTestMirror(t, http.StatusNotFound, 0)}
+
+	if _, err := c.Deployment.FindMirror(context.Background()); err == nil {
+		t.Fatal("expected error")
+	}
+	if c.Mirror == c.Mirrors[0] {
+		t.Error("mirror changed to an unavailable mirror")
+	}
+}
+
+func TestDeploymentGetFallback(t *testing.T) {
+	dead := newDeadMirror(t)
+	good := newTestMirror(t, http.StatusOK, 0)
+
+	c := NewClient()
+	c.Mirror = dead
+	c.Mirrors = []string{dead, good}
+
+	for range 2 {
+		resp, err := c.Deployment.get(context.Background(), "version.txt", 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+
+		if string(b) != "/version.txt" {
+			t.Errorf("body = %q, want %q", b, "/version.txt")
+		}
+	}
+
+	// Fallback applies to the request only
+	if c.Mirror != dead {
+		t.Errorf("mirror = %s, want %s", c.Mirror, dead)
+	}
+}
+
+func TestDeploymentGetPrimaryError(t *testing.T) {
+	c := NewClient()
+	c.Mirror = newTestMirror(t, http.StatusNotFound, 0)
+	c.Mirrors = []string{
+		newTestMirror(t, http.StatusInternalServerError, 0),
+		newDeadMirror(t),
+	}
+
+	_, err := c.Deployment.get(context.Background(), "version.txt", 0)
+
+	var statusErr *StatusError
+	if !errors.As(err, &statusErr) || statusErr.StatusCode != http.StatusNotFound {
+		t.Errorf("error = %v, want primary mirror's %d", err, http.StatusNotFound)
+	}
+}
+
+func TestParsePackageManifest(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      string
+		want    []Package
+		wantErr bool
+	}{
+		{
+			name: "packages",
+			in: "v0\r\n" +
+				"RobloxApp.zip\r\n8e0a6b9a0f3e1c4d5b6a7f8e9d0c1b2a\r\n35651234\r\n91234567\r\n" +
+				"shaders.zip\r\n0123456789abcdef0123456789abcdef\r\n1234\r\n5678\r\n",
+			want: []Package{
+				{"RobloxApp.zip", "8e0a6b9a0f3e1c4d5b6a7f8e9d0c1b2a", 35651234, 91234567},
+				{"shaders.zip", "0123456789abcdef0123456789abcdef", 1234, 5678},
+			},
+		},
+		{
+			name: "blank lines between packages",
+			in:   "v0\n\nssl.zip\nabc\n1\n2\n\n",
+			want: []Package{{"ssl.zip", "abc", 1, 2}},
+		},
+		{name: "no packages", in: "v0\n"},
+		{name: "empty", in: "", wantErr: true},
+		{name: "unknown version", in: "v1\nssl.zip\nabc\n1\n2\n", wantErr: true},
+		{name: "truncated", in: "v0\nssl.zip\nabc\n1\n", wantErr: true},
+		{name: "invalid zip size", in: "v0\nssl.zip\nabc\none\n2\n", wantErr: true},
+		{name: "invalid size", in: "v0\nssl.zip\nabc\n1\n-\n", wantErr: true},
+		{name: "parent name", in: "v0\n../escaped.txt\nabc\n1\n2\n", wantErr: true},
+		{name: "windows parent name", in: "v0\n..\\escaped.txt\nabc\n1\n2\n", wantErr: true},
+		{name: "nested name", in: "v0\ncontent/ssl.zip\nabc\n1\n2\n", wantErr: true},
+		{name: "absolute name", in: "v0\n/tmp/ssl.zip\nabc\n1\n2\n", wantErr: true},
+		{name: "dot name", in: "v0\n..\nabc\n1\n2\n", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pkgs, err := ParsePackageManifest(strings.NewReader(tt.in))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, want error %t", err, tt.wantErr)
+			}
+			if !slices.Equal(pkgs, tt.want) {
+				t.Errorf("packages = %v, want %v", pkgs, tt.want)
+			}
+		})
+	}
+}
+
+func TestListPackagesPrivateChannel(t *testing.T) {
+	var header http.Header
+	var path string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header.Clone()
+		path = r.URL.Path
+		io.WriteString(w, "v0\nssl.zip\nabc\n1\n2\n")
+	}))
+	t.Cleanup(srv.Close)
+
+	c := NewClient()
+	c.Mirror = srv.URL
+	c.Mirrors = nil
+	c.Security = "security"
+
+	d := &Deployment{
+		Type:    BinaryTypeWindowsPlayer,
+		Channel: "ZPrivate",
+		GUID:    "version-0123456789abcdef",
+		Token:   "secret",
+	}
+	if _, err := c.Deployment.ListPackages(context.Background(), d); err != nil {
+		t.Fatal(err)
+	}
+
+	if want := "/channel/zprivate/version-0123456789abcdef-rbxPkgManifest.txt"; path != want {
+		t.Errorf("path = %s, want %s", path, want)
+	}
+	for _, k := range []string{"Roblox-Channel-Token", "Cookie", "X-Csrf-Token"} {
+		if v := header.Get(k); v != "" {
+			t.Errorf("%s sent to mirror: %q", k, v)
+		}
+	}
+}
+
+func TestDownloadPackagesIllegalName(t *testing.T) {
+	c := NewClient()
+	c.Mirror = newTestMirror(t, http.StatusOK, 0)
+	c.Mirrors = nil
+
+	tmp := t.TempDir()
+	dir := filepath.Join(tmp, "downloads")
+	if err := os.Mkdir(dir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	d := &Deployment{Type: BinaryTypeWindowsPlayer, GUID: "version-0123456789abcdef"}
+	pkgs := []Package{{Name: "../escaped.txt"}}
+	if err := c.Deployment.DownloadPackages(context.Background(), d, pkgs, dir, 1); err == nil {
+		t.Error("expected error")
+	}
+	if _, err := os.Stat(filepath.Join(tmp, "escaped.txt")); err == nil {
+		t.Error("package written outside of the download directory")
+	}
+}
+
+func TestGetDeployment(t *testing.T) {
+	tests := []struct {
+		name     string
+		channel  string
+		security string
+		user     string         // user-channel response; unauthorized if empty
+		status   map[string]int // status of each requested channel, OK if unset
+		want     Deployment
+		requests []string // client version requests, with the token sent
+		wantErr  bool
+	}{
+		{
+			name:     "user on live",
+			security: "security",
+			user:     `{"channelName":"LIVE","channelAssignmentType":0}`,
+			want:     Deployment{Channel: ChannelLive, GUID: "version-live"},
+			requests: []string{"/v2/client-version/WindowsPlayer "},
+		},
+		{
+			name:     "user on production",
+			security: "security",
+			user:     `{"channelName":"production","channelAssignmentType":1}`,
+			want:     Deployment{Channel: ChannelLive, GUID: "version-live"},
+			requests: []string{"/v2/client-version/WindowsPlayer "},
+		},
+		{
+			name:     "user on private channel",
+			security: "security",
+			user:     `{"channelName":"ZBeta","channelAssignmentType":2,"token":"secret"}`,
+			want:     Deployment{Channel: "ZBeta", GUID: "version-zbeta", Token: "secret"},
+			requests: []string{"/v2/client-version/WindowsPlayer/channel/ZBeta secret"},
+		},
+		{
+			name:     "user on public channel",
+			security: "security",
+			user:     `{"channelName":"ZPublic","channelAssignmentType":3,"token":"unused"}`,
+			want:     Deployment{Channel: "ZPublic", GUID: "version-zpublic"},
+			requests: []string{"/v2/client-version/WindowsPlayer/channel/ZPublic "},
+		},
+		{
+			name:    "user channel unavailable",
+			wantErr: true,
+		},
+		{
+			name:     "explicit channel",
+			channel:  "ZBeta",
+			want:     Deployment{Channel: "ZBeta", GUID: "version-zbeta"},
+			requests: []string{"/v2/client-version/WindowsPlayer/channel/ZBeta "},
+		},
+		{
+			name:     "explicit user private channel",
+			channel:  "zbeta",
+			security: "security",
+			user:     `{"channelName":"ZBeta","channelAssignmentType":4,"token":"secret"}`,
+			want:     Deployment{Channel: "ZBeta", GUID: "version-zbeta", Token: "secret"},
+			requests: []string{"/v2/client-version/WindowsPlayer/channel/ZBeta secret"},
+		},
+		{
+			name:     "explicit other channel",
+			channel:  "ZOther",
+			security: "security",
+			user:     `{"channelName":"ZBeta","channelAssignmentType":2,"token":"secret"}`,
+			want:     Deployment{Channel: "ZOther", GUID: "version-zother"},
+			requests: []string{"/v2/client-version/WindowsPlayer/channel/ZOther "},
+		},
+		{
+			name:     "explicit production",
+			channel:  "production",
+			want:     Deployment{Channel: ChannelLive, GUID: "version-live"},
+			requests: []string{"/v2/client-version/WindowsPlayer "},
+		},
+		{
+			name:    "missing channel falls back",
+			channel: "ZMissing",
+			status:  map[string]int{"ZMissing": http.StatusNotFound},
+			want:    Deployment{Channel: ChannelLive, GUID: "version-live"},
+			requests: []string{
+				"/v2/client-version/WindowsPlayer/channel/ZMissing ",
+				"/v2/client-version/WindowsPlayer ",
+			},
+		},
+		{
+			name:    "invalid channel falls back",
+			channel: "ZInvalid",
+			status:  map[string]int{"ZInvalid": http.StatusBadRequest},
+			want:    Deployment{Channel: ChannelLive, GUID: "version-live"},
+			requests: []string{
+				"/v2/client-version/WindowsPlayer/channel/ZInvalid ",
+				"/v2/client-version/WindowsPlayer ",
+			},
+		},
+		{
+			name:     "invalid token",
+			security: "security",
+			user:     `{"channelName":"ZBeta","channelAssignmentType":2,"token":"expired"}`,
+			status:   map[string]int{"ZBeta": http.StatusForbidden},
+			requests: []string{"/v2/client-version/WindowsPlayer/channel/ZBeta expired"},
+			wantErr:  true,
+		},
+		{
+			name:     "unauthorized",
+			channel:  "ZBeta",
+			status:   map[string]int{"ZBeta": http.StatusUnauthorized},
+			requests: []string{"/v2/client-version/WindowsPlayer/channel/ZBeta "},
+			wantErr:  true,
+		},
+		{
+			name:     "rate limited",
+			channel:  "ZBeta",
+			status:   map[string]int{"ZBeta": http.StatusTooManyRequests},
+			requests: []string{"/v2/client-version/WindowsPlayer/channel/ZBeta "},
+			wantErr:  true,
+		},
+		{
+			name:     "unavailable",
+			channel:  "ZBeta",
+			status:   map[string]int{"ZBeta": http.StatusServiceUnavailable},
+			requests: []string{"/v2/client-version/WindowsPlayer/channel/ZBeta "},
+			wantErr:  true,
+		},
+		{
+			name:     "live unavailable",
+			status:   map[string]int{"": http.StatusInternalServerError},
+			security: "security",
+			user:     `{"channelName":"LIVE","channelAssignmentType":0}`,
+			requests: []string{"/v2/client-version/WindowsPlayer "},
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests []string
+			c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+
+				if r.URL.Path == "/v2/user-channel" {
+					if tt.user == "" {
+						w.WriteHeader(http.StatusUnauthorized)
+						io.WriteString(w, `{"errors":[{"code":0,"message":"Authorization has been denied for this request."}]}`)
+						return
+					}
+					io.WriteString(w, tt.user)
+					return
+				}
+
+				requests = append(requests, r.URL.Path+" "+r.Header.Get("Roblox-Channel-Token"))
+
+				channel := ""
+				if _, ch, ok := strings.Cut(r.URL.Path, "/channel/"); ok {
+					channel = ch
+				}
+				if status, ok := tt.status[channel]; ok {
+					w.WriteHeader(status)
+					io.WriteString(w, `{"errors":[{"code":1,"message":"error"}]}`)
+					return
+				}
+
+				guid := "version-live"
+				if channel != "" {
+					guid = "version-" + strings.ToLower(channel)
+				}
+				io.WriteString(w, `{"version":"0.600.0.6000521","clientVersionUpload":"`+guid+`"}`)
+			}))
+			c.Security = tt.security
+
+			d, err := c.Deployment.GetDeployment(BinaryTypeWindowsPlayer, tt.channel)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, want error %t", err, tt.wantErr)
+			}
+			if !slices.Equal(requests, tt.requests) {
+				t.Errorf("requests = %q, want %q", requests, tt.requests)
+			}
+			if err != nil {
+				return
+			}
+
+			tt.want.Type = BinaryTypeWindowsPlayer
+			tt.want.Version = "0.600.0.6000521"
+			if *d != tt.want {
+				t.Errorf("deployment = %+v, want %+v", *d, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewClientMirrors(t *testing.T) {
+	c := NewClient()
+	c.Mirrors[0] = "https://mirror.example.com"
+
+	if DefaultMirrors[0] != DefaultMirror {
+		t.Errorf("DefaultMirrors changed by client: %v", DefaultMirrors)
+	}
+	if m := NewClient().Mirrors[0]; m != DefaultMirror {
+		t.Errorf("new client mirror = %s, want %s", m, DefaultMirror)
+	}
+}
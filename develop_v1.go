@@ -0,0 +1,43 @@
+package rbxweb
+
+import (
+	"iter"
+	"net/url"
+)
+
+// DevelopServiceV1 partially handles the 'develop/v1' Roblox Web API.
+type DevelopServiceV1 service
+
+// UniversePlace implements the PlaceModel API model.
+type UniversePlace struct {
+	ID          PlaceID    `json:"id"`
+	UniverseID  UniverseID `json:"universeId"`
+	Name        string     `json:"name"`
+	Description string     `json:"description"`
+}
+
+// ListUniversePlaces returns a page of the places of the given Universe ID.
+// The cursor is optional.
+func (d *DevelopServiceV1) ListUniversePlaces(uid UniverseID, cursor string) (*Page[UniversePlace], error) {
+	var p Page[UniversePlace]
+
+	q := url.Values{"limit": {"100"}}
+	if cursor != "" {
+		q.Set("cursor", cursor)
+	}
+
+	err := d.Client.Execute("GET", "develop", path("v1/universes/%d/places", q, uid), nil, &p)
+	if err != nil {
+		return nil, err
+	}
+
+	return &p, nil
+}
+
+// UniversePlaces returns an iterator over the places of all pages of
+// ListUniversePlaces.
+func (d *DevelopServiceV1) UniversePlaces(uid UniverseID) iter.Seq2[UniversePlace, error] {
+	return paginate(func(cursor string) (*Page[UniversePlace], error) {
+		return d.ListUniversePlaces(uid, cursor)
+	})
+}
@@ -0,0 +1,73 @@
+package rbxweb
+
+import (
+	"iter"
+	"net/url"
+	"strconv"
+)
+
+// DeveloperProductsServiceV1 partially handles the 'developer-products/v1' Roblox Web API.
+type DeveloperProductsServiceV1 service
+
+// DeveloperProductID represents a Developer Product on Roblox, distinct
+// from its Product ID.
+type DeveloperProductID int64
+
+// DeveloperProduct is a representation of an unknown model returned by
+// the developer products listing.
+type DeveloperProduct struct {
+	ProductID          ProductID          `json:"ProductId"`
+	ID                 DeveloperProductID `json:"DeveloperProductId"`
+	Name               string             `json:"Name"`
+	Description        string             `json:"Description"`
+	IconImageAssetID   *AssetID           `json:"IconImageAssetId"`
+	DisplayName        string             `json:"displayName"`
+	DisplayDescription string             `json:"displayDescription"`
+	DisplayIcon        AssetID            `json:"displayIcon"`
+	Price              *int64             `json:"PriceInRobux"` // nil if not for sale
+}
+
+// ListDeveloperProducts returns the given page number, starting at 1, of the
+// developer products of the given Universe ID, and whether it is the final page.
+func (d *DeveloperProductsServiceV1) ListDeveloperProducts(uid UniverseID, page int) ([]DeveloperProduct, bool, error) {
+	dpr := struct {
+		Products []DeveloperProduct `json:"DeveloperProducts"`
+		Final    bool               `json:"FinalPage"`
+	}{}
+
+	q := url.Values{
+		"pageNumber": {strconv.Itoa(page)},
+		"pageSize":   {"50"},
+	}
+	err := d.Client.Execute("GET", "apis",
+		path("developer-products/v1/universes/%d/developerproducts", q, uid), nil, &dpr)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return dpr.Products, dpr.Final, nil
+}
+
+// DeveloperProducts returns an iterator over the developer products of all
+// pages of ListDeveloperProducts.
+func (d *DeveloperProductsServiceV1) DeveloperProducts(uid UniverseID) iter.Seq2[DeveloperProduct, error] {
+	return func(yield func(DeveloperProduct, error) bool) {
+		for page := 1; ; page++ {
+			dps, final, err := d.ListDeveloperProducts(uid, page)
+			if err != nil {
+				yield(DeveloperProduct{}, err)
+				return
+			}
+
+			for _, dp := range dps {
+				if !yield(dp, nil) {
+					return
+				}
+			}
+
+			if final || len(dps) == 0 {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,39 @@
+package rbxweb
+
+// EconomyServiceV1 partially handles the 'economy/v1' Roblox Web API.
+type EconomyServiceV1 service
+
+// ProductInfo is a representation of an unknown model returned by
+// product information endpoints.
+type ProductInfo struct {
+	TargetID         int64     `json:"TargetId"`
+	ProductType      string    `json:"ProductType"` // e.g. "Game Pass", "Developer Product"
+	AssetID          AssetID   `json:"AssetId"`
+	ProductID        ProductID `json:"ProductId"`
+	Name             string    `json:"Name"`
+	Description      string    `json:"Description"`
+	IconImageAssetID AssetID   `json:"IconImageAssetId"`
+	Created          string    `json:"Created"`
+	Updated          string    `json:"Updated"`
+	Price            *int64    `json:"PriceInRobux"` // nil if not for sale
+	IsForSale        bool      `json:"IsForSale"`
+	Creator          struct {
+		ID              int64  `json:"Id"`
+		Name            string `json:"Name"`
+		CreatorType     string `json:"CreatorType"` // One of "User", "Group"
+		CreatorTargetID int64  `json:"CreatorTargetId"`
+	} `json:"Creator"`
+}
+
+// GetDeveloperProductInfo returns the product information of the given
+// Product ID of a developer product.
+func (e *EconomyServiceV1) GetDeveloperProductInfo(pid ProductID) (*ProductInfo, error) {
+	var pi ProductInfo
+
+	err := e.Client.Execute("GET", "economy", path("v1/developer-products/%d/info", nil, pid), nil, &pi)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pi, nil
+}
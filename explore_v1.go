@@ -0,0 +1,145 @@
+package rbxweb
+
+import (
+	"crypto/rand"
+	"fmt"
+	"net/url"
+	"slices"
+)
+
+// ExploreServiceV1 partially handles the undocumented 'explore-api/v1' Roblox Web API.
+type ExploreServiceV1 service
+
+// GameTile is a representation of an unknown model of a game returned
+// by the game discovery APIs.
+type GameTile struct {
+	UniverseID     UniverseID `json:"universeId"`
+	RootPlaceID    PlaceID    `json:"rootPlaceId"`
+	Name           string     `json:"name"`
+	Description    string     `json:"description,omitempty"`
+	PlayerCount    int64      `json:"playerCount"`
+	TotalUpVotes   int64      `json:"totalUpVotes"`
+	TotalDownVotes int64      `json:"totalDownVotes"`
+	IsSponsored    bool       `json:"isSponsored"`
+	MinimumAge     int        `json:"minimumAge,omitempty"`
+}
+
+// Sort is a representation of an unknown model of a game sort returned
+// by get-sorts.
+type Sort struct {
+	ID          string     `json:"sortId"`
+	DisplayName string     `json:"sortDisplayName"`
+	ContentType string     `json:"contentType"` // e.g. "Games", "Filters"
+	Games       []GameTile `json:"games,omitempty"`
+	Next        string     `json:"nextPageToken,omitempty"`
+}
+
+// Game represents a GameTile hydrated with its details and icon.
+type Game struct {
+	GameTile
+	Detail *GameDetail
+	Icon   *Thumbnail
+}
+
+// NewSessionID returns a new random session ID, used to group related
+// game discovery requests.
+func NewSessionID() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	b[6] = b[6]&0x0f | 0x40 // version 4
+	b[8] = b[8]&0x3f | 0x80 // variant 10
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
+
+// ListSorts returns a page of the game sorts shown on the home page, and
+// the token of the next page, if any. The page token is optional.
+func (e *ExploreServiceV1) ListSorts(sessionID, pageToken string) ([]Sort, string, error) {
+	sr := struct {
+		Sorts []Sort `json:"sorts"`
+		Next  string `json:"nextSortsPageToken"`
+	}{}
+
+	q := url.Values{
+		"sessionId": {sessionID},
+		"device":    {"computer"},
+		"country":   {"all"},
+	}
+	if pageToken != "" {
+		q.Set("sortsPageToken", pageToken)
+	}
+
+	err := e.Client.Execute("GET", "apis", path("explore-api/v1/get-sorts", q), nil, &sr)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return sr.Sorts, sr.Next, nil
+}
+
+// ListSortGames returns a page of the games of the named sort ID, and
+// the token of the next page, if any. The page token is optional.
+func (e *ExploreServiceV1) ListSortGames(sessionID, sortID, pageToken string) ([]GameTile, string, error) {
+	scr := struct {
+		Games []GameTile `json:"games"`
+		Next  string     `json:"nextPageToken"`
+	}{}
+
+	q := url.Values{
+		"sessionId": {sessionID},
+		"sortId":    {sortID},
+		"device":    {"computer"},
+		"country":   {"all"},
+	}
+	if pageToken != "" {
+		q.Set("pageToken", pageToken)
+	}
+
+	err := e.Client.Execute("GET", "apis", path("explore-api/v1/get-sort-content", q), nil, &scr)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return scr.Games, scr.Next, nil
+}
+
+// HydrateGames returns the GameTiles along with their details and icons,
+// retrieved in batches with GamesServiceV1.ListGamesDetails and
+// ThumbnailsServiceV1.ListGamesIcons. The icon options are optional.
+func (e *ExploreServiceV1) HydrateGames(tiles []GameTile, opts *GameIconOptions) ([]Game, error) {
+	uids := make([]UniverseID, len(tiles))
+	for i, t := range tiles {
+		uids[i] = t.UniverseID
+	}
+
+	details := make(map[UniverseID]*GameDetail, len(tiles))
+	icons := make(map[UniverseID]*Thumbnail, len(tiles))
+	for chunk := range slices.Chunk(uids, 50) {
+		gds, err := e.Client.GamesV1.ListGamesDetails(chunk)
+		if err != nil {
+			return nil, err
+		}
+		for i := range gds {
+			// The ID of a game is that of its universe
+			details[UniverseID(gds[i].ID)] = &gds[i]
+		}
+
+		ts, err := e.Client.ThumbnailsV1.ListGamesIcons(chunk, opts)
+		if err != nil {
+			return nil, err
+		}
+		for i := range ts {
+			icons[UniverseID(ts[i].TargetID)] = &ts[i]
+		}
+	}
+
+	games := make([]Game, len(tiles))
+	for i, t := range tiles {
+		games[i] = Game{
+			GameTile: t,
+			Detail:   details[t.UniverseID],
+			Icon:     icons[t.UniverseID],
+		}
+	}
+
+	return games, nil
+}
@@ -0,0 +1,322 @@
+package rbxweb
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// ErrFlagNotFound is returned when a flag is not present in ApplicationSettings.
+var ErrFlagNotFound = errors.New("flag not found")
+
+// FlagType represents the value type of a fast flag, determined by
+// the prefix of its name.
+type FlagType int
+
+const (
+	FlagTypeUnknown FlagType = iota
+	FlagTypeBool             // FFlag
+	FlagTypeInt              // FInt
+	FlagTypeString           // FString
+	FlagTypeLog              // FLog, an integer log level
+)
+
+// String implements the Stringer interface.
+func (ft FlagType) String() string {
+	switch ft {
+	case FlagTypeBool:
+		return "bool"
+	case FlagTypeInt:
+		return "int"
+	case FlagTypeString:
+		return "string"
+	case FlagTypeLog:
+		return "log"
+	default:
+		return "unknown"
+	}
+}
+
+// Flag represents the parsed name of a fast flag, such as DFIntTaskSchedulerTargetFps.
+type Flag struct {
+	Name         string // Full name of the flag, including its prefix
+	Base         string // Name of the flag without its prefix
+	Type         FlagType
+	Dynamic      bool // DF prefix, may change without a client restart
+	Synchronized bool // SF prefix, synchronized between client and server
+}
+
+var flagTypes = []struct {
+	prefix string
+	typ    FlagType
+}{
+	{"FFlag", FlagTypeBool},
+	{"FInt", FlagTypeInt},
+	{"FString", FlagTypeString},
+	{"FLog", FlagTypeLog},
+}
+
+// ParseFlag parses the named fast flag by its prefix. If the prefix is
+// unknown, the flag's type is FlagTypeUnknown.
+func ParseFlag(name string) Flag {
+	f := Flag{Name: name, Base: name}
+
+	rest := name
+	switch {
+	case strings.HasPrefix(rest, "DF"):
+		f.Dynamic = true
+		rest = rest[1:]
+	case strings.HasPrefix(rest, "SF"):
+		f.Synchronized = true
+		rest = rest[1:]
+	}
+
+	for _, t := range flagTypes {
+		if strings.HasPrefix(rest, t.prefix) {
+			f.Type = t.typ
+			f.Base = strings.TrimPrefix(rest, t.prefix)
+			return f
+		}
+	}
+
+	return Flag{Name: name, Base: name}
+}
+
+// ApplicationSettings represents the fast flags of an application, keyed by
+// their full names. All values are served as strings.
+type ApplicationSettings map[string]string
+
+func (as ApplicationSettings) lookup(name string, ft FlagType) (string, error) {
+	if t := ParseFlag(name).Type; t != ft {
+		return "", fmt.Errorf("flag %s: type %s is not %s", name, t, ft)
+	}
+
+	v, ok := as[name]
+	if !ok {
+		return "", fmt.Errorf("flag %s: %w", name, ErrFlagNotFound)
+	}
+	return v, nil
+}
+
+// Bool returns the value of the named FFlag, DFFlag or SFFlag.
+func (as ApplicationSettings) Bool(name string) (bool, error) {
+	v, err := as.lookup(name, FlagTypeBool)
+	if err != nil {
+		return false, err
+	}
+
+	switch {
+	case strings.EqualFold(v, "true"):
+		return true, nil
+	case strings.EqualFold(v, "false"):
+		return false, nil
+	default:
+		return false, fmt.Errorf("flag %s: invalid bool %q", name, v)
+	}
+}
+
+// Int returns the value of the named FInt, DFInt or SFInt.
+func (as ApplicationSettings) Int(name string) (int64, error) {
+	v, err := as.lookup(name, FlagTypeInt)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseInt(v, 10, 64)
+}
+
+// StringFlag returns the value of the named FString, DFString or SFString.
+// It is not named String, as to not be mistaken for a [fmt.Stringer].
+func (as ApplicationSettings) StringFlag(name string) (string, error) {
+	return as.lookup(name, FlagTypeString)
+}
+
+// Log returns the log level of the named FLog, DFLog or SFLog.
+func (as ApplicationSettings) Log(name string) (int64, error) {
+	v, err := as.lookup(name, FlagTypeLog)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseInt(v, 10, 64)
+}
+
+// FlagChange represents a change of a fast flag between two
+// ApplicationSettings.
+type FlagChange struct {
+	Name    string
+	Old     string
+	New     string
+	Added   bool // Not present in the old settings
+	Removed bool // Not present in the new settings
+}
+
+// DiffApplicationSettings returns the changes of fast flags from the old
+// settings to the new settings, sorted by name.
+func DiffApplicationSettings(old, new ApplicationSettings) []FlagChange {
+	var changes []FlagChange
+
+	for name, ov := range old {
+		nv, ok := new[name]
+		switch {
+		case !ok:
+			changes = append(changes, FlagChange{Name: name, Old: ov, Removed: true})
+		case ov != nv:
+			changes = append(changes, FlagChange{Name: name, Old: ov, New: nv})
+		}
+	}
+	for name, nv := range new {
+		if _, ok := old[name]; !ok {
+			changes = append(changes, FlagChange{Name: name, New: nv, Added: true})
+		}
+	}
+
+	slices.SortFunc(changes, func(a, b FlagChange) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return changes
+}
+
+// FlagIssue represents an issue found with a fast flag override.
+type FlagIssue int
+
+const (
+	FlagIssueUnknown    FlagIssue = iota + 1 // The flag is not served
+	FlagIssueDeprecated                      // The flag is served under a different prefix
+	FlagIssuePrefix                          // The flag's prefix is unknown
+	FlagIssueValue                           // The value does not match the flag's type
+)
+
+// FlagError represents an issue found with a fast flag override.
+type FlagError struct {
+	Name  string
+	Issue FlagIssue
+	// Served is the name the flag is served as, for FlagIssueDeprecated.
+	Served string
+}
+
+// Error implements the error interface.
+func (e FlagError) Error() string {
+	switch e.Issue {
+	case FlagIssueUnknown:
+		return "flag " + e.Name + ": unknown flag"
+	case FlagIssueDeprecated:
+		return "flag " + e.Name + ": deprecated, served as " + e.Served
+	case FlagIssuePrefix:
+		return "flag " + e.Name + ": unknown prefix"
+	case FlagIssueValue:
+		return "flag " + e.Name + ": invalid " + ParseFlag(e.Name).Type.String() + " value"
+	default:
+		return "flag " + e.Name + ": invalid"
+	}
+}
+
+// ValidateFlags checks the fast flag overrides against the served fast
+// flags, and returns the issues found, sorted by name. Values are checked
+// against the type of each flag's prefix; booleans and integers may also be
+// given as strings, as accepted by Roblox.
+func ValidateFlags(served ApplicationSettings, overrides map[string]any) []FlagError {
+	bases := make(map[string]string, len(served))
+	for name := range served {
+		bases[ParseFlag(name).Base] = name
+	}
+
+	var errs []FlagError
+	for name, v := range overrides {
+		f := ParseFlag(name)
+		if f.Type == FlagTypeUnknown {
+			errs = append(errs, FlagError{Name: name, Issue: FlagIssuePrefix})
+			continue
+		}
+		if !validFlagValue(f.Type, v) {
+			errs = append(errs, FlagError{Name: name, Issue: FlagIssueValue})
+			continue
+		}
+		if _, ok := served[name]; ok {
+			continue
+		}
+
+		if s, ok := bases[f.Base]; ok {
+			errs = append(errs, FlagError{Name: name, Issue: FlagIssueDeprecated, Served: s})
+		} else {
+			errs = append(errs, FlagError{Name: name, Issue: FlagIssueUnknown})
+		}
+	}
+
+	slices.SortFunc(errs, func(a, b FlagError) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return errs
+}
+
+func validFlagValue(ft FlagType, v any) bool {
+	switch ft {
+	case FlagTypeBool:
+		switch v := v.(type) {
+		case bool:
+			return true
+		case string:
+			return strings.EqualFold(v, "true") || strings.EqualFold(v, "false")
+		}
+	case FlagTypeInt, FlagTypeLog:
+		switch v := v.(type) {
+		case int, int32, int64:
+			return true
+		case float64:
+			return v == math.Trunc(v)
+		case json.Number:
+			_, err := v.Int64()
+			return err == nil
+		case string:
+			_, err := strconv.ParseInt(v, 10, 64)
+			return err == nil
+		}
+	case FlagTypeString:
+		_, ok := v.(string)
+		return ok
+	}
+	return false
+}
+
+// ValidateFlags checks the fast flag overrides against the fast flags
+// served for the BinaryType's application on the named deployment channel.
+//
+// See [ValidateFlags] for more details.
+func (c *ClientSettingsServiceV2) ValidateFlags(bt BinaryType, channel string, overrides map[string]any) ([]FlagError, error) {
+	served, err := c.GetApplicationSettings(bt, channel)
+	if err != nil {
+		return nil, err
+	}
+
+	return ValidateFlags(served, overrides), nil
+}
+
+// WriteFlags writes the fast flag overrides as ClientAppSettings.json to
+// the ClientSettings directory of the BinaryType's installation directory
+// dir. The overrides should be validated beforehand with ValidateFlags.
+//
+// For Mac installations, the ClientSettings directory resides within the
+// application bundle.
+func WriteFlags(bt BinaryType, dir string, overrides map[string]any) error {
+	switch bt.Platform() + bt.Short() {
+	case "MacPlayer":
+		dir = filepath.Join(dir, "RobloxPlayer.app", "Contents", "MacOS")
+	case "MacStudio":
+		dir = filepath.Join(dir, "RobloxStudio.app", "Contents", "MacOS")
+	}
+	dir = filepath.Join(dir, "ClientSettings")
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(overrides, "", "\t")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(dir, "ClientAppSettings.json"), data, 0o644)
+}
@@ -0,0 +1,157 @@
+package rbxweb
+
+import (
+	"encoding/json"
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestParseFlag(t *testing.T) {
+	tests := []struct {
+		name string
+		want Flag
+	}{
+		{"FFlagDebugGraphicsPreferVulkan", Flag{
+			Name: "FFlagDebugGraphicsPreferVulkan", Base: "DebugGraphicsPreferVulkan", Type: FlagTypeBool,
+		}},
+		{"DFIntTaskSchedulerTargetFps", Flag{
+			Name: "DFIntTaskSchedulerTargetFps", Base: "TaskSchedulerTargetFps", Type: FlagTypeInt, Dynamic: true,
+		}},
+		{"SFStringGameName", Flag{
+			Name: "SFStringGameName", Base: "GameName", Type: FlagTypeString, Synchronized: true,
+		}},
+		{"FLogNetwork", Flag{
+			Name: "FLogNetwork", Base: "Network", Type: FlagTypeLog,
+		}},
+		{"DFLogNetwork", Flag{
+			Name: "DFLogNetwork", Base: "Network", Type: FlagTypeLog, Dynamic: true,
+		}},
+		{"DebugGraphics", Flag{Name: "DebugGraphics", Base: "DebugGraphics"}},
+		{"DFDebug", Flag{Name: "DFDebug", Base: "DFDebug"}},
+		{"DF", Flag{Name: "DF", Base: "DF"}},
+		{"FFlag", Flag{Name: "FFlag", Type: FlagTypeBool}},
+		{"", Flag{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ParseFlag(tt.name); got != tt.want {
+				t.Errorf("ParseFlag(%q) = %+v, want %+v", tt.name, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestApplicationSettings(t *testing.T) {
+	as := ApplicationSettings{
+		"FFlagEnabled":        "True",
+		"DFFlagDisabled":      "false",
+		"FFlagInvalid":        "yes",
+		"DFIntTargetFps":      "60",
+		"FIntInvalid":         "6O",
+		"SFStringName":        "Crossroads",
+		"FLogNetwork":         "7",
+		"FStringEmptyDefault": "",
+	}
+
+	if v, err := as.Bool("FFlagEnabled"); err != nil || !v {
+		t.Errorf("FFlagEnabled = %t, %v", v, err)
+	}
+	if v, err := as.Bool("DFFlagDisabled"); err != nil || v {
+		t.Errorf("DFFlagDisabled = %t, %v", v, err)
+	}
+	if v, err := as.Int("DFIntTargetFps"); err != nil || v != 60 {
+		t.Errorf("DFIntTargetFps = %d, %v", v, err)
+	}
+	if v, err := as.StringFlag("SFStringName"); err != nil || v != "Crossroads" {
+		t.Errorf("SFStringName = %q, %v", v, err)
+	}
+	if v, err := as.StringFlag("FStringEmptyDefault"); err != nil || v != "" {
+		t.Errorf("FStringEmptyDefault = %q, %v", v, err)
+	}
+	if v, err := as.Log("FLogNetwork"); err != nil || v != 7 {
+		t.Errorf("FLogNetwork = %d, %v", v, err)
+	}
+
+	if _, err := as.Bool("FFlagMissing"); !errors.Is(err, ErrFlagNotFound) {
+		t.Errorf("FFlagMissing error = %v, want %v", err, ErrFlagNotFound)
+	}
+	if _, err := as.Bool("FFlagInvalid"); err == nil {
+		t.Error("FFlagInvalid: expected error")
+	}
+	if _, err := as.Int("FIntInvalid"); err == nil {
+		t.Error("FIntInvalid: expected error")
+	}
+	if _, err := as.Int("FFlagEnabled"); err == nil {
+		t.Error("FFlagEnabled as int: expected error")
+	}
+}
+
+func TestValidateFlags(t *testing.T) {
+	served := ApplicationSettings{
+		"FFlagDebugGraphicsPreferVulkan": "false",
+		"DFIntTaskSchedulerTargetFps":    "60",
+		"FStringVoiceBetaBadgeUrl":       "",
+		"FLogNetwork":                    "0",
+	}
+
+	overrides := map[string]any{
+		"FFlagDebugGraphicsPreferVulkan": true,
+		"DFIntTaskSchedulerTargetFps":    json.Number("144"),
+		"FStringVoiceBetaBadgeUrl":       "https://example.com",
+		"FLogNetwork":                    "7",
+
+		"FIntTaskSchedulerTargetFps":  float64(240), // served as DFInt
+		"FFlagDoesNotExist":           "True",
+		"DebugGraphicsPreferVulkan":   true,
+		"DFIntTaskSchedulerTargetFpz": 1.5,
+		"FStringVoiceBetaBadgeUr1":    7,
+		"FLogNetworc":                 "seven",
+	}
+
+	want := []FlagError{
+		{Name: "DFIntTaskSchedulerTargetFpz", Issue: FlagIssueValue},
+		{Name: "DebugGraphicsPreferVulkan", Issue: FlagIssuePrefix},
+		{Name: "FFlagDoesNotExist", Issue: FlagIssueUnknown},
+		{Name: "FIntTaskSchedulerTargetFps", Issue: FlagIssueDeprecated, Served: "DFIntTaskSchedulerTargetFps"},
+		{Name: "FLogNetworc", Issue: FlagIssueValue},
+		{Name: "FStringVoiceBetaBadgeUr1", Issue: FlagIssueValue},
+	}
+
+	if got := ValidateFlags(served, overrides); !slices.Equal(got, want) {
+		t.Errorf("ValidateFlags = %v, want %v", got, want)
+	}
+}
+
+func TestValidFlagValue(t *testing.T) {
+	tests := []struct {
+		ft   FlagType
+		v    any
+		want bool
+	}{
+		{FlagTypeBool, true, true},
+		{FlagTypeBool, "False", true},
+		{FlagTypeBool, "1", false},
+		{FlagTypeBool, 1, false},
+		{FlagTypeInt, 60, true},
+		{FlagTypeInt, int64(-1), true},
+		{FlagTypeInt, float64(60), true},
+		{FlagTypeInt, 60.5, false},
+		{FlagTypeInt, "60", true},
+		{FlagTypeInt, "", false},
+		{FlagTypeInt, json.Number("1e3"), false},
+		{FlagTypeInt, true, false},
+		{FlagTypeLog, "7", true},
+		{FlagTypeString, "", true},
+		{FlagTypeString, 1, false},
+		{FlagTypeUnknown, "", false},
+		{FlagTypeString, nil, false},
+	}
+
+	for _, tt := range tests {
+		if got := validFlagValue(tt.ft, tt.v); got != tt.want {
+			t.Errorf("validFlagValue(%s, %#v) = %t, want %t", tt.ft, tt.v, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,17 @@
+package rbxweb
+
+// GamePassesServiceV1 partially handles the 'game-passes/v1' Roblox Web API.
+type GamePassesServiceV1 service
+
+// GetGamePassInfo returns the product information of the given Game Pass ID.
+func (g *GamePassesServiceV1) GetGamePassInfo(id GamePassID) (*ProductInfo, error) {
+	var pi ProductInfo
+
+	err := g.Client.Execute("GET", "apis",
+		path("game-passes/v1/game-passes/%d/product-info", nil, id), nil, &pi)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pi, nil
+}
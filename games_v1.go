@@ -1,7 +1,10 @@
 package rbxweb
 
 import (
+	"errors"
+	"iter"
 	"net/url"
+	"strconv"
 )
 
 // GamesServiceV1 partially handles the 'games/v1' Roblox Web API.
@@ -81,6 +84,81 @@ type PlaceDetail struct {
 	ImageToken        string     `json:"imageToken"`
 }
 
+// ServerType represents the type of a game server.
+type ServerType string
+
+const (
+	ServerTypePublic ServerType = "Public"
+	ServerTypeFriend ServerType = "Friend"
+	ServerTypeVIP    ServerType = "VIP" // Private servers of the authenticated user
+)
+
+// ServerPlayer implements the GameServerPlayerResponse API model.
+type ServerPlayer struct {
+	Token       string `json:"playerToken"`
+	ID          UserID `json:"id"`
+	Name        string `json:"name"`
+	DisplayName string `json:"displayName"`
+}
+
+// Server implements the GameServerResponse API model.
+type Server struct {
+	ID           string          `json:"id"` // Job ID
+	MaxPlayers   int32           `json:"maxPlayers"`
+	Playing      int32           `json:"playing"`
+	PlayerTokens []string        `json:"playerTokens"`
+	Players      []ServerPlayer  `json:"players"`
+	FPS          float64         `json:"fps"`
+	Ping         int64           `json:"ping"`
+	Name         string          `json:"name,omitempty"`        // Private servers only
+	VIPServerID  PrivateServerID `json:"vipServerId,omitempty"` // Private servers only
+	AccessCode   string          `json:"accessCode,omitempty"`  // Private servers only
+	Owner        *User           `json:"owner,omitempty"`       // Private servers only
+}
+
+// ServerOptions provides parameters for listing game servers.
+type ServerOptions struct {
+	SortOrder        SortOrder
+	ExcludeFullGames bool
+	Limit            int // one of 10, 25, 50, 100
+}
+
+// ListServers returns a page of the servers of the given type running the
+// given Place ID. The options and cursor are optional.
+func (g *GamesServiceV1) ListServers(pid PlaceID, st ServerType, opts *ServerOptions, cursor string) (*Page[Server], error) {
+	var p Page[Server]
+
+	q := url.Values{}
+	if opts != nil {
+		if opts.SortOrder != "" {
+			q.Set("sortOrder", string(opts.SortOrder))
+		}
+		if opts.ExcludeFullGames {
+			q.Set("excludeFullGames", "true")
+		}
+		if opts.Limit > 0 {
+			q.Set("limit", strconv.Itoa(opts.Limit))
+		}
+	}
+	if cursor != "" {
+		q.Set("cursor", cursor)
+	}
+
+	err := g.Client.Execute("GET", "games", path("v1/games/%d/servers/%s", q, pid, st), nil, &p)
+	if err != nil {
+		return nil, err
+	}
+
+	return &p, nil
+}
+
+// Servers returns an iterator over the servers of all pages of ListServers.
+func (g *GamesServiceV1) Servers(pid PlaceID, st ServerType, opts *ServerOptions) iter.Seq2[Server, error] {
+	return paginate(func(cursor string) (*Page[Server], error) {
+		return g.ListServers(pid, st, opts, cursor)
+	})
+}
+
 // GetGamesDetail returns a list of the game details of each given Universe ID.
 func (g *GamesServiceV1) ListGamesDetails(uids []UniverseID) ([]GameDetail, error) {
 	gdr := struct {
@@ -122,3 +200,378 @@ func (g *GamesServiceV1) ListPlacesDetails(pids []PlaceID) ([]PlaceDetail, error
 func (g *GamesServiceV1) GetPlaceDetail(placeID PlaceID) (*PlaceDetail, error) {
 	return getList(g.ListPlacesDetails([]PlaceID{placeID}))
 }
+
+// GameVotes implements the GameVoteResponse API model.
+type GameVotes struct {
+	ID        UniverseID `json:"id"`
+	UpVotes   int64      `json:"upVotes"`
+	DownVotes int64      `json:"downVotes"`
+}
+
+// UserVote implements the UserGameVoteResponse API model.
+type UserVote struct {
+	CanVote bool `json:"canVote"`
+	// Vote is true for an upvote, false for a downvote, and nil if the
+	// user has not voted.
+	Vote   *bool  `json:"userVote"`
+	Reason string `json:"reasonForNotVoteable"`
+}
+
+// SocialLink implements the SocialLinkResponse API model.
+type SocialLink struct {
+	ID    int64  `json:"id"`
+	Type  string `json:"type"` // e.g. "Facebook", "Twitter", "YouTube", "Twitch", "Discord", "RobloxGroup", "Guilded"
+	URL   string `json:"url"`
+	Title string `json:"title"`
+}
+
+// ListGamesVotes returns a list of the votes of each given Universe ID.
+func (g *GamesServiceV1) ListGamesVotes(uids []UniverseID) ([]GameVotes, error) {
+	gvr := struct {
+		Data []GameVotes `json:"data"`
+	}{}
+
+	query := url.Values{"universeIds": formatSlice(uids)}
+	err := g.Client.Execute("GET", "games", path("v1/games/votes", query), nil, &gvr)
+	if err != nil {
+		return nil, err
+	}
+
+	return gvr.Data, nil
+}
+
+// GetGameVotes returns the given Universe ID's votes.
+//
+// If none are found, nil will be returned.
+func (g *GamesServiceV1) GetGameVotes(uid UniverseID) (*GameVotes, error) {
+	return getList(g.ListGamesVotes([]UniverseID{uid}))
+}
+
+// GetUserVote returns the authenticated user's vote on the given Universe ID.
+func (g *GamesServiceV1) GetUserVote(uid UniverseID) (*UserVote, error) {
+	var uv UserVote
+
+	err := g.Client.Execute("GET", "games", path("v1/games/%d/votes/user", nil, uid), nil, &uv)
+	if err != nil {
+		return nil, err
+	}
+
+	return &uv, nil
+}
+
+// SetUserVote sets the authenticated user's vote on the given Universe ID,
+// true for an upvote and false for a downvote. If the vote is nil, the
+// user's vote is removed.
+func (g *GamesServiceV1) SetUserVote(uid UniverseID, vote *bool) error {
+	req := struct {
+		Vote *bool `json:"vote"`
+	}{vote}
+
+	return g.Client.Execute("PATCH", "games", path("v1/games/%d/user-votes", nil, uid), req, nil)
+}
+
+// GetFavorite reports whether the given Universe ID is favorited by the
+// authenticated user.
+func (g *GamesServiceV1) GetFavorite(uid UniverseID) (bool, error) {
+	fr := struct {
+		Favorited bool `json:"isFavorited"`
+	}{}
+
+	err := g.Client.Execute("GET", "games", path("v1/games/%d/favorites", nil, uid), nil, &fr)
+	if err != nil {
+		return false, err
+	}
+
+	return fr.Favorited, nil
+}
+
+// SetFavorite favorites or unfavorites the given Universe ID for the
+// authenticated user.
+func (g *GamesServiceV1) SetFavorite(uid UniverseID, favorite bool) error {
+	req := struct {
+		Favorited bool `json:"isFavorited"`
+	}{favorite}
+
+	return g.Client.Execute("POST", "games", path("v1/games/%d/favorites", nil, uid), req, nil)
+}
+
+// GetFavoritesCount returns the amount of favorites of the given Universe ID.
+func (g *GamesServiceV1) GetFavoritesCount(uid UniverseID) (int64, error) {
+	fcr := struct {
+		Count int64 `json:"favoritesCount"`
+	}{}
+
+	err := g.Client.Execute("GET", "games", path("v1/games/%d/favorites/count", nil, uid), nil, &fcr)
+	if err != nil {
+		return 0, err
+	}
+
+	return fcr.Count, nil
+}
+
+// ListSocialLinks returns the social links of the given Universe ID.
+func (g *GamesServiceV1) ListSocialLinks(uid UniverseID) ([]SocialLink, error) {
+	slr := struct {
+		Data []SocialLink `json:"data"`
+	}{}
+
+	err := g.Client.Execute("GET", "games", path("v1/games/%d/social-links/list", nil, uid), nil, &slr)
+	if err != nil {
+		return nil, err
+	}
+
+	return slr.Data, nil
+}
+
+// PrivateServerID represents a private (VIP) server on Roblox.
+type PrivateServerID int64
+
+// ErrPrivateServersDisabled is returned when creating a private server for
+// a game that does not allow private servers.
+var ErrPrivateServersDisabled = errors.New("private servers are not allowed for this game")
+
+// PrivateServersTab represents a tab of the private servers page.
+type PrivateServersTab string
+
+const (
+	PrivateServersTabMine  PrivateServersTab = "MyPrivateServers"
+	PrivateServersTabOther PrivateServersTab = "OtherPrivateServers"
+)
+
+// PrivateServerSummary implements the PrivateServerDetailsResponse API model.
+type PrivateServerSummary struct {
+	ID             PrivateServerID `json:"privateServerId"`
+	Name           string          `json:"name"`
+	Active         bool            `json:"active"`
+	UniverseID     UniverseID      `json:"universeId"`
+	UniverseName   string          `json:"universeName"`
+	PlaceID        PlaceID         `json:"placeId"`
+	OwnerID        UserID          `json:"ownerId"`
+	OwnerName      string          `json:"ownerName"`
+	ExpirationDate string          `json:"expirationDate"`
+	WillRenew      bool            `json:"willRenew"`
+	Price          int64           `json:"priceInRobux"`
+}
+
+// PrivateServerPermissions implements the VipServerPermissionsResponse API model.
+type PrivateServerPermissions struct {
+	ClanAllowed    bool    `json:"clanAllowed"`
+	EnemyClanID    GroupID `json:"enemyClanId"`
+	FriendsAllowed bool    `json:"friendsAllowed"`
+	Users          []User  `json:"users"`
+}
+
+// PrivateServer implements the VipServerResponse API model.
+type PrivateServer struct {
+	ID       PrivateServerID `json:"id"`
+	Name     string          `json:"name"`
+	Active   bool            `json:"active"`
+	JoinCode string          `json:"joinCode"`
+	Link     string          `json:"link"` // Share link of the private server
+	Game     struct {
+		ID        UniverseID `json:"id"`
+		Name      string     `json:"name"`
+		RootPlace struct {
+			ID   PlaceID `json:"id"`
+			Name string  `json:"name"`
+		} `json:"rootPlace"`
+	} `json:"game"`
+	Subscription struct {
+		Active         bool   `json:"active"`
+		Expired        bool   `json:"expired"`
+		ExpirationDate string `json:"expirationDate"`
+		Price          int64  `json:"price"`
+		WillRenew      bool   `json:"willRenew"`
+	} `json:"subscription"`
+	Permissions   PrivateServerPermissions `json:"permissions"`
+	VoiceSettings struct {
+		Enabled bool `json:"enabled"`
+	} `json:"voiceSettings"`
+
+	// AccessCode is the code required to launch into the private server,
+	// if available to the authenticated user.
+	AccessCode string `json:"-"`
+}
+
+// PrivateServerPermissionsUpdate provides the permission fields of a
+// private server to be updated; only non-nil fields are updated.
+type PrivateServerPermissionsUpdate struct {
+	ClanAllowed    *bool    `json:"clanAllowed,omitempty"`
+	EnemyClanID    *GroupID `json:"enemyClanId,omitempty"`
+	FriendsAllowed *bool    `json:"friendsAllowed,omitempty"`
+	UsersToAdd     []UserID `json:"usersToAdd,omitempty"`
+	UsersToRemove  []UserID `json:"usersToRemove,omitempty"`
+}
+
+// ListPrivateServers returns a page of the private servers of the
+// authenticated user in the given tab. The cursor is optional.
+func (g *GamesServiceV1) ListPrivateServers(tab PrivateServersTab, cursor string) (*Page[PrivateServerSummary], error) {
+	var p Page[PrivateServerSummary]
+
+	q := url.Values{
+		"privateServersTab": {string(tab)},
+		"itemsPerPage":      {"100"},
+	}
+	if cursor != "" {
+		q.Set("cursor", cursor)
+	}
+
+	err := g.Client.Execute("GET", "games", path("v1/private-servers/my-private-servers", q), nil, &p)
+	if err != nil {
+		return nil, err
+	}
+
+	return &p, nil
+}
+
+// PrivateServers returns an iterator over the private servers of all pages
+// of ListPrivateServers.
+func (g *GamesServiceV1) PrivateServers(tab PrivateServersTab) iter.Seq2[PrivateServerSummary, error] {
+	return paginate(func(cursor string) (*Page[PrivateServerSummary], error) {
+		return g.ListPrivateServers(tab, cursor)
+	})
+}
+
+// GetPrivateServer returns the private server of the given Private Server ID.
+//
+// The access code of the private server is retrieved from the private servers
+// of the game's root place, and is left empty if it is not available, such as
+// when the private servers can not be listed.
+func (g *GamesServiceV1) GetPrivateServer(id PrivateServerID) (*PrivateServer, error) {
+	var ps PrivateServer
+
+	err := g.Client.Execute("GET", "games", path("v1/vip-servers/%d", nil, id), nil, &ps)
+	if err != nil {
+		return nil, err
+	}
+
+	for s, err := range g.Servers(ps.Game.RootPlace.ID, ServerTypeVIP, &ServerOptions{Limit: 100}) {
+		if err != nil {
+			break
+		}
+		if s.VIPServerID == id {
+			ps.AccessCode = s.AccessCode
+			break
+		}
+	}
+
+	return &ps, nil
+}
+
+// CreatePrivateServer purchases a new private server with the given name in
+// the given Universe ID at the expected price. If the game does not allow
+// private servers, ErrPrivateServersDisabled is returned.
+func (g *GamesServiceV1) CreatePrivateServer(uid UniverseID, name string, price int64) (*PrivateServer, error) {
+	gd, err := g.GetGameDetail(uid)
+	if err != nil {
+		return nil, err
+	}
+	if gd == nil || !gd.CreateVipServersAllowed {
+		return nil, ErrPrivateServersDisabled
+	}
+
+	req := struct {
+		Name      string `json:"name"`
+		Price     int64  `json:"expectedPrice"`
+		Confirmed bool   `json:"isPurchaseConfirmed"`
+	}{name, price, true}
+
+	var ps PrivateServer
+	err = g.Client.Execute("POST", "games", path("v1/games/vip-servers/%d", nil, uid), req, &ps)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ps, nil
+}
+
+func (g *GamesServiceV1) updatePrivateServer(id PrivateServerID, body any) (*PrivateServer, error) {
+	var ps PrivateServer
+
+	err := g.Client.Execute("PATCH", "games", path("v1/vip-servers/%d", nil, id), body, &ps)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ps, nil
+}
+
+// RenamePrivateServer renames the given Private Server ID.
+func (g *GamesServiceV1) RenamePrivateServer(id PrivateServerID, name string) (*PrivateServer, error) {
+	return g.updatePrivateServer(id, struct {
+		Name string `json:"name"`
+	}{name})
+}
+
+// SetPrivateServerActive activates or deactivates the given Private Server ID.
+func (g *GamesServiceV1) SetPrivateServerActive(id PrivateServerID, active bool) (*PrivateServer, error) {
+	return g.updatePrivateServer(id, struct {
+		Active bool `json:"active"`
+	}{active})
+}
+
+// RegeneratePrivateServerLink regenerates the join code and link of the
+// given Private Server ID, invalidating the previous link.
+func (g *GamesServiceV1) RegeneratePrivateServerLink(id PrivateServerID) (*PrivateServer, error) {
+	return g.updatePrivateServer(id, struct {
+		NewJoinCode bool `json:"newJoinCode"`
+	}{true})
+}
+
+// UpdatePrivateServerPermissions updates who is permitted to join the given
+// Private Server ID, and returns the updated permissions.
+func (g *GamesServiceV1) UpdatePrivateServerPermissions(id PrivateServerID, pu *PrivateServerPermissionsUpdate) (*PrivateServerPermissions, error) {
+	var psp PrivateServerPermissions
+
+	err := g.Client.Execute("PATCH", "games", path("v1/vip-servers/%d/permissions", nil, id), pu, &psp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &psp, nil
+}
+
+// GamePassID represents a Game Pass on Roblox.
+type GamePassID int64
+
+// ProductID represents a purchasable product on Roblox, such as of a Game
+// Pass or Developer Product.
+type ProductID int64
+
+// GamePass implements the GamePassResponse API model.
+type GamePass struct {
+	ID          GamePassID `json:"id"`
+	Name        string     `json:"name"`
+	DisplayName string     `json:"displayName"`
+	ProductID   ProductID  `json:"productId"`
+	Price       *int64     `json:"price"` // nil if not for sale
+	SellerName  string     `json:"sellerName"`
+	SellerID    *UserID    `json:"sellerId"`
+	IsOwned     bool       `json:"isOwned"`
+}
+
+// ListGamePasses returns a page of the game passes of the given Universe ID.
+// The cursor is optional.
+func (g *GamesServiceV1) ListGamePasses(uid UniverseID, cursor string) (*Page[GamePass], error) {
+	var p Page[GamePass]
+
+	q := url.Values{"limit": {"100"}}
+	if cursor != "" {
+		q.Set("cursor", cursor)
+	}
+
+	err := g.Client.Execute("GET", "games", path("v1/games/%d/game-passes", q, uid), nil, &p)
+	if err != nil {
+		return nil, err
+	}
+
+	return &p, nil
+}
+
+// GamePasses returns an iterator over the game passes of all pages of
+// ListGamePasses.
+func (g *GamesServiceV1) GamePasses(uid UniverseID) iter.Seq2[GamePass, error] {
+	return paginate(func(cursor string) (*Page[GamePass], error) {
+		return g.ListGamePasses(uid, cursor)
+	})
+}
@@ -0,0 +1,67 @@
+package rbxweb
+
+import (
+	"io"
+	"net/http"
+	"testing"
+)
+
+func TestGetPrivateServer(t *testing.T) {
+	tests := []struct {
+		name    string
+		servers func(w http.ResponseWriter, r *http.Request)
+		want    string
+	}{
+		{
+			name: "access code",
+			servers: func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Query().Get("cursor") == "" {
+					io.WriteString(w, `{"nextPageCursor":"next","data":[{"id":"job-a","vipServerId":1,"accessCode":"code-a"}]}`)
+					return
+				}
+				io.WriteString(w, `{"data":[{"id":"job-b","vipServerId":7,"accessCode":"code-b"}]}`)
+			},
+			want: "code-b",
+		},
+		{
+			name: "not listed",
+			servers: func(w http.ResponseWriter, r *http.Request) {
+				io.WriteString(w, `{"data":[{"id":"job-a","vipServerId":1,"accessCode":"code-a"}]}`)
+			},
+		},
+		{
+			name: "listing forbidden",
+			servers: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusForbidden)
+				io.WriteString(w, `{"errors":[{"code":0,"message":"Unauthorized"}]}`)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch r.URL.Path {
+				case "/v1/vip-servers/7":
+					io.WriteString(w, `{"id":7,"name":"Friends","active":true,"game":{"id":1,"rootPlace":{"id":1818}}}`)
+				case "/v1/games/1818/servers/VIP":
+					tt.servers(w, r)
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+
+			ps, err := c.GamesV1.GetPrivateServer(7)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if ps.ID != 7 || ps.Name != "Friends" {
+				t.Errorf("private server = %+v", ps)
+			}
+			if ps.AccessCode != tt.want {
+				t.Errorf("access code = %q, want %q", ps.AccessCode, tt.want)
+			}
+		})
+	}
+}
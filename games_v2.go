@@ -0,0 +1,31 @@
+package rbxweb
+
+// GamesServiceV2 partially handles the 'games/v2' Roblox Web API.
+type GamesServiceV2 service
+
+// GameMedia implements the GameMediaItemResponseV2 API model.
+type GameMedia struct {
+	AssetTypeID int64  `json:"assetTypeId"`
+	AssetType   string `json:"assetType"` // One of "Image", "YouTubeVideo"
+	ImageID     int64  `json:"imageId"`
+	VideoHash   string `json:"videoHash,omitempty"`
+	VideoTitle  string `json:"videoTitle,omitempty"`
+	VideoID     string `json:"videoId,omitempty"` // YouTube video ID
+	Approved    bool   `json:"approved"`
+	AltText     string `json:"altText,omitempty"`
+}
+
+// ListGameMedia returns the screenshots and videos of the given Universe ID.
+// Thumbnails of the media may be retrieved with ThumbnailsServiceV1.ListGamesThumbnails.
+func (g *GamesServiceV2) ListGameMedia(uid UniverseID) ([]GameMedia, error) {
+	gmr := struct {
+		Data []GameMedia `json:"data"`
+	}{}
+
+	err := g.Client.Execute("GET", "games", path("v2/games/%d/media", nil, uid), nil, &gmr)
+	if err != nil {
+		return nil, err
+	}
+
+	return gmr.Data, nil
+}
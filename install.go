@@ -0,0 +1,378 @@
+package rbxweb
+
+import (
+	"archive/zip"
+	"bufio"
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// AppSettings is the content of AppSettings.xml, required to be present
+// within the root of a Roblox installation.
+const AppSettings = `<?xml version="1.0" encoding="UTF-8"?>
+<Settings>
+	<ContentFolder>content</ContentFolder>
+	<BaseUrl>http://www.roblox.com</BaseUrl>
+</Settings>
+`
+
+// PlayerDirectories maps each package of a Player deployment to the directory
+// it is extracted to, relative to the installation directory.
+var PlayerDirectories = map[string]string{
+	"RobloxApp.zip":                     "",
+	"redist.zip":                        "",
+	"WebView2.zip":                      "",
+	"WebView2RuntimeInstaller.zip":      "WebView2RuntimeInstaller",
+	"shaders.zip":                       "shaders",
+	"ssl.zip":                           "ssl",
+	"content-avatar.zip":                "content/avatar",
+	"content-configs.zip":               "content/configs",
+	"content-fonts.zip":                 "content/fonts",
+	"content-sky.zip":                   "content/sky",
+	"content-sounds.zip":                "content/sounds",
+	"content-textures2.zip":             "content/textures",
+	"content-models.zip":                "content/models",
+	"content-platform-fonts.zip":        "PlatformContent/pc/fonts",
+	"content-platform-dictionaries.zip": "PlatformContent/pc/shared_compression_dictionaries",
+	"content-terrain.zip":               "PlatformContent/pc/terrain",
+	"content-textures3.zip":             "PlatformContent/pc/textures",
+	"extracontent-luapackages.zip":      "ExtraContent/LuaPackages",
+	"extracontent-translations.zip":     "ExtraContent/translations",
+	"extracontent-models.zip":           "ExtraContent/models",
+	"extracontent-textures.zip":         "ExtraContent/textures",
+	"extracontent-places.zip":           "ExtraContent/places",
+}
+
+// StudioDirectories maps each package of a Studio deployment to the directory
+// it is extracted to, relative to the installation directory.
+var StudioDirectories = map[string]string{
+	"RobloxStudio.zip":                  "",
+	"redist.zip":                        "",
+	"Libraries.zip":                     "",
+	"LibrariesQt5.zip":                  "",
+	"WebView2.zip":                      "",
+	"WebView2RuntimeInstaller.zip":      "WebView2RuntimeInstaller",
+	"shaders.zip":                       "shaders",
+	"ssl.zip":                           "ssl",
+	"Qml.zip":                           "Qml",
+	"Plugins.zip":                       "Plugins",
+	"StudioFonts.zip":                   "StudioFonts",
+	"BuiltInPlugins.zip":                "BuiltInPlugins",
+	"BuiltInStandalonePlugins.zip":      "BuiltInStandalonePlugins",
+	"ApplicationConfig.zip":             "ApplicationConfig",
+	"content-avatar.zip":                "content/avatar",
+	"content-configs.zip":               "content/configs",
+	"content-fonts.zip":                 "content/fonts",
+	"content-sky.zip":                   "content/sky",
+	"content-sounds.zip":                "content/sounds",
+	"content-textures2.zip":             "content/textures",
+	"content-models.zip":                "content/models",
+	"content-api-docs.zip":              "content/api_docs",
+	"content-qt_translations.zip":       "content/qt_translations",
+	"content-studio_svg_textures.zip":   "content/studio_svg_textures",
+	"content-platform-fonts.zip":        "PlatformContent/pc/fonts",
+	"content-platform-dictionaries.zip": "PlatformContent/pc/shared_compression_dictionaries",
+	"content-terrain.zip":               "PlatformContent/pc/terrain",
+	"content-textures3.zip":             "PlatformContent/pc/textures",
+	"extracontent-luapackages.zip":      "ExtraContent/LuaPackages",
+	"extracontent-translations.zip":     "ExtraContent/translations",
+	"extracontent-models.zip":           "ExtraContent/models",
+	"extracontent-textures.zip":         "ExtraContent/textures",
+	"extracontent-scripts.zip":          "ExtraContent/scripts",
+	"studiocontent-models.zip":          "StudioContent/models",
+	"studiocontent-textures.zip":        "StudioContent/textures",
+}
+
+// MacPlayerDirectories maps the package of a Mac Player deployment, being
+// the application bundle, to the directory it is extracted to.
+var MacPlayerDirectories = map[string]string{
+	"RobloxPlayer.zip": "",
+}
+
+// MacStudioDirectories maps the package of a Mac Studio deployment, being
+// the application bundle, to the directory it is extracted to.
+var MacStudioDirectories = map[string]string{
+	"RobloxStudioApp.zip": "",
+}
+
+// PackageDirectories returns the package directory map of the BinaryType,
+// or nil if there is none.
+func (bt BinaryType) PackageDirectories() map[string]string {
+	switch bt.Platform() + bt.Short() {
+	case "WindowsPlayer":
+		return PlayerDirectories
+	case "WindowsStudio":
+		return StudioDirectories
+	case "MacPlayer":
+		return MacPlayerDirectories
+	case "MacStudio":
+		return MacStudioDirectories
+	default:
+		return nil
+	}
+}
+
+// ExtractPackage extracts the named package zip file of the BinaryType to
+// the package's directory within the installation directory dir.
+//
+// Files within the package that would be extracted outside of the package's
+// directory are rejected.
+func ExtractPackage(bt BinaryType, name, dir string) error {
+	pkgDir, ok := bt.PackageDirectories()[filepath.Base(name)]
+	if !ok {
+		return fmt.Errorf("package %s: unhandled for %s", filepath.Base(name), bt)
+	}
+
+	return extractZip(name, filepath.Join(dir, filepath.FromSlash(pkgDir)))
+}
+
+func extractZip(name, dir string) error {
+	zr, err := zip.OpenReader(name)
+	if err != nil {
+		return err
+	}
+	defer zr.Close()
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	root, err := filepath.EvalSymlinks(dir)
+	if err != nil {
+		return err
+	}
+
+	for _, zf := range zr.File {
+		// Packages use Windows path separators
+		slash := strings.ReplaceAll(zf.Name, `\`, "/")
+		p := filepath.FromSlash(slash)
+		if !filepath.IsLocal(p) {
+			return fmt.Errorf("zip %s: illegal file path %s", filepath.Base(name), zf.Name)
+		}
+
+		if zf.FileInfo().IsDir() || strings.HasSuffix(slash, "/") {
+			dst, err := resolveLocal(root, filepath.Join(root, p))
+			if err != nil {
+				return fmt.Errorf("zip %s: %w", filepath.Base(name), err)
+			}
+			if err := os.MkdirAll(dst, 0o755); err != nil {
+				return err
+			}
+			continue
+		}
+
+		// Links extracted earlier may lead anywhere; the parent is
+		// resolved to ensure the file is written within the directory.
+		parent, err := resolveLocal(root, filepath.Join(root, filepath.Dir(p)))
+		if err != nil {
+			return fmt.Errorf("zip %s: %w", filepath.Base(name), err)
+		}
+		if err := os.MkdirAll(parent, 0o755); err != nil {
+			return err
+		}
+		dst := filepath.Join(parent, filepath.Base(p))
+
+		// Never write through a link extracted earlier
+		if fi, err := os.Lstat(dst); err == nil && fi.Mode()&fs.ModeSymlink != 0 {
+			if err := os.Remove(dst); err != nil {
+				return err
+			}
+		}
+
+		if zf.Mode()&fs.ModeSymlink != 0 {
+			err = extractZipSymlink(zf, root, dst)
+		} else {
+			err = extractZipFile(zf, dst)
+		}
+		if err != nil {
+			return fmt.Errorf("zip %s: %w", filepath.Base(name), err)
+		}
+	}
+
+	return nil
+}
+
+// resolveLocal returns the path p with the symbolic links of its existing
+// components resolved, rejecting it if it resolves outside of root, which
+// must itself be resolved.
+func resolveLocal(root, p string) (string, error) {
+	rest := ""
+	cur := p
+
+	for {
+		_, err := os.Lstat(cur)
+		if errors.Is(err, fs.ErrNotExist) {
+			parent := filepath.Dir(cur)
+			if parent == cur {
+				return "", fmt.Errorf("path %s: %w", p, err)
+			}
+			rest = filepath.Join(filepath.Base(cur), rest)
+			cur = parent
+			continue
+		} else if err != nil {
+			return "", err
+		}
+
+		// Fails for dangling links, which can not be safely followed
+		r, err := filepath.EvalSymlinks(cur)
+		if err != nil {
+			return "", err
+		}
+		r = filepath.Join(r, rest)
+
+		rel, err := filepath.Rel(root, r)
+		if err != nil || !filepath.IsLocal(rel) {
+			return "", fmt.Errorf("illegal path %s: resolves outside of %s", p, root)
+		}
+
+		return r, nil
+	}
+}
+
+// extractZipSymlink creates the symbolic link of the zip file, as present
+// within application bundles, at dst. Links pointing outside of root are
+// rejected; parent references are only permitted at the start of the link.
+func extractZipSymlink(zf *zip.File, root, dst string) error {
+	src, err := zf.Open()
+	if err != nil {
+		return err
+	}
+	target, err := io.ReadAll(src)
+	src.Close()
+	if err != nil {
+		return err
+	}
+
+	elems := strings.Split(string(target), "/")
+	i := 0
+	for i < len(elems) && elems[i] == ".." {
+		i++
+	}
+
+	t := filepath.FromSlash(string(target))
+	if filepath.IsAbs(t) || slices.Contains(elems[i:], "..") {
+		return fmt.Errorf("illegal link %s to %s", zf.Name, target)
+	}
+	if _, err := resolveLocal(root, filepath.Join(filepath.Dir(dst), t)); err != nil {
+		return fmt.Errorf("illegal link %s to %s: %w", zf.Name, target, err)
+	}
+
+	return os.Symlink(t, dst)
+}
+
+func extractZipFile(zf *zip.File, dst string) error {
+	src, err := zf.Open()
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	f, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, zf.Mode().Perm()|0o600)
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.Copy(f, src); err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
+
+// WriteAppSettings writes AppSettings to the installation directory dir.
+func WriteAppSettings(dir string) error {
+	return os.WriteFile(filepath.Join(dir, "AppSettings.xml"), []byte(AppSettings), 0o644)
+}
+
+// ParseFileManifest parses the rbxManifest format read from r, consisting
+// of two lines for each file of an installation: path and MD5 checksum.
+// The returned map is keyed by the slash-separated path of each file, which
+// must be within the installation.
+func ParseFileManifest(r io.Reader) (map[string]string, error) {
+	files := make(map[string]string)
+	s := bufio.NewScanner(r)
+
+	for s.Scan() {
+		p := strings.TrimSpace(s.Text())
+		if p == "" {
+			continue
+		}
+		if !s.Scan() {
+			if err := s.Err(); err != nil {
+				return nil, err
+			}
+			return nil, fmt.Errorf("truncated file manifest: %s has no checksum", p)
+		}
+		slash := strings.ReplaceAll(p, `\`, "/")
+		if !filepath.IsLocal(filepath.FromSlash(slash)) {
+			return nil, fmt.Errorf("file manifest: illegal file path %s", p)
+		}
+		files[slash] = strings.TrimSpace(s.Text())
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	if len(files) == 0 {
+		return nil, errors.New("empty file manifest")
+	}
+
+	return files, nil
+}
+
+// GetFileManifest returns the files of the Deployment's file manifest.
+//
+// See ParseFileManifest for more details.
+func (ds *DeploymentService) GetFileManifest(ctx context.Context, d *Deployment) (map[string]string, error) {
+	resp, err := ds.get(ctx, d.Path("rbxManifest.txt"), 0)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return ParseFileManifest(resp.Body)
+}
+
+// VerifyInstall verifies the files of the installation directory dir against
+// the file manifest, and returns the sorted paths of the files that are missing
+// or do not match their checksum. Paths outside of the installation directory
+// are rejected.
+func VerifyInstall(dir string, files map[string]string) ([]string, error) {
+	var bad []string
+
+	for p, sum := range files {
+		if !filepath.IsLocal(filepath.FromSlash(p)) {
+			return nil, fmt.Errorf("illegal file path %s", p)
+		}
+
+		f, err := os.Open(filepath.Join(dir, filepath.FromSlash(p)))
+		if errors.Is(err, os.ErrNotExist) {
+			bad = append(bad, p)
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+
+		h := md5.New()
+		_, err = io.Copy(h, f)
+		f.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		if !strings.EqualFold(hex.EncodeToString(h.Sum(nil)), sum) {
+			bad = append(bad, p)
+		}
+	}
+	slices.Sort(bad)
+
+	return bad, nil
+}
@@ -0,0 +1,265 @@
+package rbxweb
+
+import (
+	"archive/zip"
+	"io/fs"
+	"maps"
+	"os"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"strings"
+	"testing"
+)
+
+type zipEntry struct {
+	name string
+	body string
+	link bool
+}
+
+func writeZip(t *testing.T, name string, entries []zipEntry) {
+	t.Helper()
+
+	f, err := os.Create(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	zw := zip.NewWriter(f)
+	for _, e := range entries {
+		fh := &zip.FileHeader{Name: e.name, Method: zip.Store}
+		if e.link {
+			fh.SetMode(fs.ModeSymlink | 0o777)
+		} else {
+			fh.SetMode(0o644)
+		}
+
+		w, err := zw.CreateHeader(fh)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := w.Write([]byte(e.body)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestExtractPackage(t *testing.T) {
+	tmp := t.TempDir()
+	pkg := filepath.Join(tmp, "RobloxApp.zip")
+	dir := filepath.Join(tmp, "install")
+
+	writeZip(t, pkg, []zipEntry{
+		{name: `RobloxPlayerBeta.exe`, body: "exe"},
+		{name: `content\fonts\`},
+		{name: `content\fonts\arial.ttf`, body: "font"},
+	})
+
+	if err := ExtractPackage(BinaryTypeWindowsPlayer, pkg, dir); err != nil {
+		t.Fatal(err)
+	}
+
+	for name, want := range map[string]string{
+		"RobloxPlayerBeta.exe":    "exe",
+		"content/fonts/arial.ttf": "font",
+	} {
+		b, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(name)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(b) != want {
+			t.Errorf("%s = %q, want %q", name, b, want)
+		}
+	}
+}
+
+func TestExtractPackageIllegal(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("symbolic links require privileges on Windows")
+	}
+
+	tests := []struct {
+		name    string
+		entries []zipEntry
+	}{
+		{"parent path", []zipEntry{
+			{name: "../evil", body: "evil"},
+		}},
+		{"windows parent path", []zipEntry{
+			{name: `..\evil`, body: "evil"},
+		}},
+		{"absolute link", []zipEntry{
+			{name: "l", body: "/", link: true},
+			{name: "l/evil", body: "evil"},
+		}},
+		{"parent link", []zipEntry{
+			{name: "l", body: "..", link: true},
+			{name: "l/evil", body: "evil"},
+		}},
+		{"inner parent link", []zipEntry{
+			{name: "d/", body: ""},
+			{name: "d/l", body: "../../x/..", link: true},
+		}},
+		{"chained links", []zipEntry{
+			{name: "d/l", body: "..", link: true},
+			{name: "d/l/m", body: "..", link: true},
+			{name: "d/l/m/evil", body: "evil"},
+		}},
+		{"chained link parent", []zipEntry{
+			{name: "d/s", body: "..", link: true},
+			{name: "d/t", body: "s/..", link: true},
+			{name: "d/t/evil", body: "evil"},
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmp := t.TempDir()
+			pkg := filepath.Join(tmp, "RobloxPlayer.zip")
+			dir := filepath.Join(tmp, "install")
+
+			writeZip(t, pkg, tt.entries)
+
+			if err := ExtractPackage(BinaryTypeMacPlayer, pkg, dir); err == nil {
+				t.Error("expected error")
+			}
+			if _, err := os.Stat(filepath.Join(tmp, "evil")); err == nil {
+				t.Error("file written outside of the installation directory")
+			}
+		})
+	}
+}
+
+func TestExtractPackageLinks(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("symbolic links require privileges on Windows")
+	}
+
+	tmp := t.TempDir()
+	pkg := filepath.Join(tmp, "RobloxPlayer.zip")
+	dir := filepath.Join(tmp, "install")
+
+	fw := "RobloxPlayer.app/Contents/Frameworks/Foo.framework/"
+	writeZip(t, pkg, []zipEntry{
+		{name: fw + "Versions/A/Foo", body: "foo"},
+		{name: fw + "Versions/Current", body: "A", link: true},
+		{name: fw + "Foo", body: "Versions/Current/Foo", link: true},
+		{name: "RobloxPlayer.app/Contents/Resources/Frameworks", body: "../Frameworks", link: true},
+	})
+
+	if err := ExtractPackage(BinaryTypeMacPlayer, pkg, dir); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, name := range []string{
+		fw + "Foo",
+		"RobloxPlayer.app/Contents/Resources/Frameworks/Foo.framework/Foo",
+	} {
+		b, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(name)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(b) != "foo" {
+			t.Errorf("%s = %q, want %q", name, b, "foo")
+		}
+	}
+}
+
+func TestParseFileManifest(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      string
+		want    map[string]string
+		wantErr bool
+	}{
+		{
+			name: "files",
+			in: "AppSettings.xml\r\nd41d8cd98f00b204e9800998ecf8427e\r\n" +
+				"content\\fonts\\arial.ttf\r\n0123456789abcdef0123456789abcdef\r\n",
+			want: map[string]string{
+				"AppSettings.xml":         "d41d8cd98f00b204e9800998ecf8427e",
+				"content/fonts/arial.ttf": "0123456789abcdef0123456789abcdef",
+			},
+		},
+		{
+			name: "blank lines",
+			in:   "\nssl\\cacert.pem\nabc\n\n",
+			want: map[string]string{"ssl/cacert.pem": "abc"},
+		},
+		{name: "empty", in: "", wantErr: true},
+		{name: "blank", in: "\n\n", wantErr: true},
+		{name: "truncated", in: "a.dll\nabc\nb.dll\n", wantErr: true},
+		{name: "parent path", in: "..\\..\\etc\\passwd\nabc\n", wantErr: true},
+		{name: "slash parent path", in: "content/../../evil\nabc\n", wantErr: true},
+		{name: "absolute path", in: "/etc/passwd\nabc\n", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			files, err := ParseFileManifest(strings.NewReader(tt.in))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, want error %t", err, tt.wantErr)
+			}
+			if !maps.Equal(files, tt.want) {
+				t.Errorf("files = %v, want %v", files, tt.want)
+			}
+		})
+	}
+}
+
+func TestVerifyInstall(t *testing.T) {
+	dir := t.TempDir()
+	for name, body := range map[string]string{
+		"a.dll":            "a",
+		"content/b.ttf":    "b",
+		"content/c.ttf":    "modified",
+		"RobloxPlayer.exe": "exe",
+	} {
+		p := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(p, []byte(body), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	bad, err := VerifyInstall(dir, map[string]string{
+		"a.dll":            "0cc175b9c0f1b6a831c399e269772661", // md5("a")
+		"content/b.ttf":    "92EB5FFEE6AE2FEC3AD71C777531578F", // md5("b")
+		"content/c.ttf":    "4a8a08f09d37b73795649038408b5f33", // md5("c")
+		"content/d.ttf":    "8277e0910d750195b448797616e091ad", // md5("d")
+		"RobloxPlayer.exe": "0cc175b9c0f1b6a831c399e269772661", // md5("a")
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{"RobloxPlayer.exe", "content/c.ttf", "content/d.ttf"}
+	if !slices.Equal(bad, want) {
+		t.Errorf("bad = %v, want %v", bad, want)
+	}
+}
+
+func TestVerifyInstallIllegal(t *testing.T) {
+	tmp := t.TempDir()
+	dir := filepath.Join(tmp, "install")
+	if err := os.Mkdir(dir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(tmp, "secret"), []byte("a"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, p := range []string{"../secret", "content/../../secret", "/etc/passwd"} {
+		_, err := VerifyInstall(dir, map[string]string{p: "0cc175b9c0f1b6a831c399e269772661"})
+		if err == nil {
+			t.Errorf("%s: expected error", p)
+		}
+	}
+}
@@ -0,0 +1,33 @@
+package rbxweb
+
+// InventoryServiceV1 partially handles the 'inventory/v1' Roblox Web API.
+type InventoryServiceV1 service
+
+// ItemType represents the type of an item in a user's inventory.
+type ItemType string
+
+const (
+	ItemTypeAsset    ItemType = "Asset"
+	ItemTypeGamePass ItemType = "GamePass"
+	ItemTypeBadge    ItemType = "Badge"
+	ItemTypeBundle   ItemType = "Bundle"
+)
+
+// IsOwned reports whether the given User ID owns the item of the given type
+// and ID, such as a GamePassID.
+func (i *InventoryServiceV1) IsOwned(uid UserID, it ItemType, id int64) (bool, error) {
+	var owned bool
+
+	err := i.Client.Execute("GET", "inventory",
+		path("v1/users/%d/items/%s/%d/is-owned", nil, uid, it, id), nil, &owned)
+	if err != nil {
+		return false, err
+	}
+
+	return owned, nil
+}
+
+// OwnsGamePass reports whether the given User ID owns the given Game Pass ID.
+func (i *InventoryServiceV1) OwnsGamePass(uid UserID, id GamePassID) (bool, error) {
+	return i.IsOwned(uid, ItemTypeGamePass, int64(id))
+}
@@ -0,0 +1,100 @@
+package rbxweb
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// GroupID represents a Group (community) on Roblox.
+type GroupID int64
+
+// ErrUnknownLink is returned when a URL is not a recognized Roblox link.
+var ErrUnknownLink = errors.New("unknown roblox link")
+
+// Link represents the target of a parsed Roblox URL. Only the fields
+// relevant to the link are set.
+type Link struct {
+	PlaceID               PlaceID
+	UserID                UserID
+	GroupID               GroupID
+	PrivateServerLinkCode string
+	ShareCode             string
+	ShareType             ShareLinkType
+}
+
+var localeSegment = regexp.MustCompile(`^[a-z]{2}(-[a-z]{2})?$`)
+
+// ParseLink parses the Roblox URL, which must be of the BaseDomain of the
+// Client. The following URLs are recognized, with the scheme being optional:
+//
+//	https://www.roblox.com/games/1818/Crossroads?privateServerLinkCode=...
+//	https://www.roblox.com/share?code=...&type=Server
+//	https://www.roblox.com/users/1/profile
+//	https://www.roblox.com/groups/7/...
+//	https://www.roblox.com/communities/7/...
+//
+// Share links may be resolved to their target with ShareLinksServiceV1.ResolveLink.
+func (c *Client) ParseLink(raw string) (*Link, error) {
+	if !strings.Contains(raw, "://") {
+		raw = "https://" + raw
+	}
+
+	u, err := url.Parse(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	host := strings.ToLower(u.Hostname())
+	domain := strings.ToLower(c.BaseDomain)
+	if host != domain && !strings.HasSuffix(host, "."+domain) {
+		return nil, fmt.Errorf("%w: host %s", ErrUnknownLink, host)
+	}
+
+	segs := strings.FieldsFunc(strings.ToLower(u.Path), func(r rune) bool { return r == '/' })
+	if len(segs) > 1 && localeSegment.MatchString(segs[0]) {
+		segs = segs[1:]
+	}
+	if len(segs) == 0 {
+		return nil, ErrUnknownLink
+	}
+
+	q := u.Query()
+	l := new(Link)
+
+	switch segs[0] {
+	case "share", "share-links":
+		l.ShareCode = q.Get("code")
+		l.ShareType = ShareLinkType(q.Get("type"))
+		if l.ShareCode == "" {
+			return nil, fmt.Errorf("%w: missing share code", ErrUnknownLink)
+		}
+		return l, nil
+	case "games", "users", "groups", "communities":
+	default:
+		return nil, fmt.Errorf("%w: path %s", ErrUnknownLink, u.Path)
+	}
+
+	if len(segs) < 2 {
+		return nil, fmt.Errorf("%w: missing ID", ErrUnknownLink)
+	}
+	id, err := strconv.ParseInt(segs[1], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid ID %s", ErrUnknownLink, segs[1])
+	}
+
+	switch segs[0] {
+	case "games":
+		l.PlaceID = PlaceID(id)
+		l.PrivateServerLinkCode = q.Get("privateServerLinkCode")
+	case "users":
+		l.UserID = UserID(id)
+	case "groups", "communities":
+		l.GroupID = GroupID(id)
+	}
+
+	return l, nil
+}
@@ -0,0 +1,92 @@
+package rbxweb
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseLink(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    Link
+		wantErr bool
+	}{
+		{
+			in:   "https://www.roblox.com/games/1818/Crossroads",
+			want: Link{PlaceID: 1818},
+		},
+		{
+			in:   "www.roblox.com/games/1818",
+			want: Link{PlaceID: 1818},
+		},
+		{
+			in:   "https://roblox.com/games/1818/Crossroads?privateServerLinkCode=0123456789",
+			want: Link{PlaceID: 1818, PrivateServerLinkCode: "0123456789"},
+		},
+		{
+			in:   "https://www.roblox.com/de/games/1818/Crossroads",
+			want: Link{PlaceID: 1818},
+		},
+		{
+			in:   "https://www.roblox.com/pt-br/users/1/profile",
+			want: Link{UserID: 1},
+		},
+		{
+			in:   "https://WWW.ROBLOX.COM/Users/1/profile",
+			want: Link{UserID: 1},
+		},
+		{
+			in:   "https://www.roblox.com/groups/7/Roblox",
+			want: Link{GroupID: 7},
+		},
+		{
+			in:   "https://www.roblox.com/communities/7/Roblox",
+			want: Link{GroupID: 7},
+		},
+		{
+			in:   "https://www.roblox.com/share?code=abc123&type=Server",
+			want: Link{ShareCode: "abc123", ShareType: ShareLinkTypeServer},
+		},
+		{
+			in:   "https://www.roblox.com/share-links?code=abc123&type=ExperienceDetails",
+			want: Link{ShareCode: "abc123", ShareType: ShareLinkTypeExperienceDetails},
+		},
+		{in: "https://www.roblox.com.evil.com/games/1818", wantErr: true},
+		{in: "https://notroblox.com/games/1818", wantErr: true},
+		{in: "https://www.roblox.com/", wantErr: true},
+		{in: "https://www.roblox.com/games", wantErr: true},
+		{in: "https://www.roblox.com/games/", wantErr: true},
+		{in: "https://www.roblox.com/games/Crossroads", wantErr: true},
+		{in: "https://www.roblox.com/games/1818abc", wantErr: true},
+		{in: "https://www.roblox.com/catalog/1818", wantErr: true},
+		{in: "https://www.roblox.com/share?type=Server", wantErr: true},
+		{in: "https://www.roblox.com/de", wantErr: true},
+		{in: "", wantErr: true},
+		{in: "https://www.roblox.com/games/%zz", wantErr: true},
+	}
+
+	c := NewClient()
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			l, err := c.ParseLink(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, want error %t", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if *l != tt.want {
+				t.Errorf("link = %+v, want %+v", *l, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseLinkUnknown(t *testing.T) {
+	c := NewClient()
+
+	_, err := c.ParseLink("https://www.roblox.com/catalog/1818")
+	if !errors.Is(err, ErrUnknownLink) {
+		t.Errorf("error = %v, want %v", err, ErrUnknownLink)
+	}
+}
@@ -0,0 +1,46 @@
+package rbxweb
+
+import (
+	"errors"
+	"net/url"
+	"unicode/utf8"
+)
+
+// MessagingServiceV1 handles the 'messaging-service/v1' Roblox Open Cloud API.
+//
+// Requests require an API key with the universe-messaging-service:publish
+// permission to be set in the Client.
+type MessagingServiceV1 service
+
+const (
+	// MaxTopicLength is the maximum amount of characters in a topic name.
+	MaxTopicLength = 80
+	// MaxMessageSize is the maximum size in bytes of a published message.
+	MaxMessageSize = 1024
+)
+
+var (
+	ErrInvalidTopic    = errors.New("topic name must be between 1 and 80 characters")
+	ErrMessageTooLarge = errors.New("message exceeds 1KB")
+)
+
+// PublishMessage publishes the message to the topic of all live servers
+// in the given Universe ID.
+//
+// The topic and message are validated against the limits of the API before
+// the request is made.
+func (m *MessagingServiceV1) PublishMessage(uid UniverseID, topic, message string) error {
+	if n := utf8.RuneCountInString(topic); n == 0 || n > MaxTopicLength {
+		return ErrInvalidTopic
+	}
+	if len(message) > MaxMessageSize {
+		return ErrMessageTooLarge
+	}
+
+	req := struct {
+		Message string `json:"message"`
+	}{message}
+
+	return m.Client.Execute("POST", "apis",
+		path("messaging-service/v1/universes/%d/topics/%s", nil, uid, url.PathEscape(topic)), req, nil)
+}
@@ -0,0 +1,98 @@
+package rbxweb
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestPublishMessage(t *testing.T) {
+	var got struct {
+		Host    string
+		Path    string
+		APIKey  string
+		Content string
+		Body    map[string]any
+	}
+
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got.Host = r.Host
+		got.Path = r.URL.EscapedPath()
+		got.APIKey = r.Header.Get("x-api-key")
+		got.Content = r.Header.Get("Content-Type")
+		if err := json.NewDecoder(r.Body).Decode(&got.Body); err != nil {
+			t.Error(err)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	c.APIKey = "key"
+
+	if err := c.MessagingV1.PublishMessage(1818, "admin/broadcast", "hello"); err != nil {
+		t.Fatal(err)
+	}
+
+	if got.Host != "apis.example.com" {
+		t.Errorf("host = %s, want apis.example.com", got.Host)
+	}
+	if want := "/messaging-service/v1/universes/1818/topics/admin%2Fbroadcast"; got.Path != want {
+		t.Errorf("path = %s, want %s", got.Path, want)
+	}
+	if got.APIKey != "key" {
+		t.Errorf("x-api-key = %q, want %q", got.APIKey, "key")
+	}
+	if got.Content != "application/json" {
+		t.Errorf("content type = %s, want application/json", got.Content)
+	}
+	if len(got.Body) != 1 || got.Body["message"] != "hello" {
+		t.Errorf("body = %v, want {message: hello}", got.Body)
+	}
+}
+
+func TestPublishMessageLimits(t *testing.T) {
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("unexpected request")
+	}))
+
+	tests := []struct {
+		name    string
+		topic   string
+		message string
+		err     error
+	}{
+		{"empty topic", "", "hello", ErrInvalidTopic},
+		{"long topic", strings.Repeat("t", MaxTopicLength+1), "hello", ErrInvalidTopic},
+		{"large message", "topic", strings.Repeat("m", MaxMessageSize+1), ErrMessageTooLarge},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := c.MessagingV1.PublishMessage(1818, tt.topic, tt.message)
+			if !errors.Is(err, tt.err) {
+				t.Errorf("error = %v, want %v", err, tt.err)
+			}
+		})
+	}
+}
+
+func TestPublishMessageQuota(t *testing.T) {
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusTooManyRequests)
+		w.Write([]byte(`{"error":"RESOURCE_EXHAUSTED","message":"Quota exceeded."}`))
+	}))
+
+	err := c.MessagingV1.PublishMessage(1818, "topic", "hello")
+
+	var cloudErr *CloudError
+	if !errors.As(err, &cloudErr) {
+		t.Fatalf("error = %v, want CloudError", err)
+	}
+	if cloudErr.Code != "RESOURCE_EXHAUSTED" || cloudErr.StatusCode != http.StatusTooManyRequests {
+		t.Errorf("error = %+v", cloudErr)
+	}
+	if !cloudErr.Quota() {
+		t.Error("expected quota error")
+	}
+}
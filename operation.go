@@ -0,0 +1,83 @@
+package rbxweb
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	operationInitialDelay = time.Second
+	operationMaxDelay     = 30 * time.Second
+)
+
+// Operation implements the long-running Operation Open Cloud model. Once
+// the operation is done, either Response or Error will be set.
+type Operation[T any] struct {
+	Path     string      `json:"path"`
+	Done     bool        `json:"done"`
+	Error    *CloudError `json:"error,omitempty"`
+	Response *T          `json:"response,omitempty"`
+
+	client *Client
+	base   string // API path that Path is relative to
+}
+
+// executeOperation wraps around Execute for Open Cloud requests that
+// return an Operation, where base is the API path that the operation's
+// path is relative to.
+func executeOperation[T any](c *Client, method, base, path string, body any) (*Operation[T], error) {
+	op := &Operation[T]{client: c, base: base}
+	if err := c.Execute(method, "apis", base+path, body, op); err != nil {
+		return nil, err
+	}
+	return op, nil
+}
+
+// Poll retrieves and updates the current state of the operation.
+func (op *Operation[T]) Poll(ctx context.Context) error {
+	req, err := op.client.NewRequest("GET", "apis", op.base+op.Path, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = op.client.Do(req.WithContext(ctx), op)
+	return err
+}
+
+// Wait polls the operation with an increasing interval until it is done or
+// the context is cancelled, and returns the operation's response. If the
+// operation failed, its error is returned.
+func (op *Operation[T]) Wait(ctx context.Context) (*T, error) {
+	delay := operationInitialDelay
+	for !op.Done {
+		t := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return nil, ctx.Err()
+		case <-t.C:
+		}
+		delay = min(delay*2, operationMaxDelay)
+
+		if err := op.Poll(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	if op.Error != nil {
+		return nil, op.Error
+	}
+	return op.Response, nil
+}
+
+// Cancel requests the operation to be cancelled. Not all Open Cloud APIs
+// support cancelling operations, in which case the API error is returned.
+func (op *Operation[T]) Cancel(ctx context.Context) error {
+	req, err := op.client.NewRequest("POST", "apis", op.base+op.Path+":cancel", struct{}{})
+	if err != nil {
+		return err
+	}
+
+	_, err = op.client.Do(req.WithContext(ctx), nil)
+	return err
+}
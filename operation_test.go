@@ -0,0 +1,131 @@
+package rbxweb
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"slices"
+	"testing"
+)
+
+func TestOperation(t *testing.T) {
+	var requests []string
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.RequestURI())
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/cloud/v2/users/1:generateThumbnail":
+			io.WriteString(w, `{"path":"users/1/operations/abc","done":false}`)
+		case "/cloud/v2/users/1/operations/abc":
+			io.WriteString(w, `{"path":"users/1/operations/abc","done":true,`+
+				`"response":{"@type":"type.googleapis.com/roblox.open_cloud.cloud.v2.GenerateUserThumbnailResponse",`+
+				`"imageUri":"https://tr.rbxcdn.com/abc/420/420/AvatarHeadshot/Png"}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	op, err := c.CloudV2.GenerateUserThumbnail(1, 420, ThumbnailFormatPng, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if op.Done {
+		t.Fatal("operation done before polling")
+	}
+
+	if err := op.Poll(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	ut, err := op.Wait(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ut.ImageURI != "https://tr.rbxcdn.com/abc/420/420/AvatarHeadshot/Png" {
+		t.Errorf("image = %s", ut.ImageURI)
+	}
+
+	want := []string{
+		"GET /cloud/v2/users/1:generateThumbnail?format=PNG&shape=ROUND&size=420",
+		"GET /cloud/v2/users/1/operations/abc",
+	}
+	if !slices.Equal(requests, want) {
+		t.Errorf("requests = %q, want %q", requests, want)
+	}
+}
+
+func TestOperationError(t *testing.T) {
+	tests := []struct {
+		name  string
+		err   string
+		code  string
+		quota bool
+	}{
+		{"invalid argument", `{"code":3,"message":"Invalid size."}`, "INVALID_ARGUMENT", false},
+		{"resource exhausted", `{"code":8,"message":"Quota exceeded."}`, "RESOURCE_EXHAUSTED", true},
+		{"named code", `{"code":"RESOURCE_EXHAUSTED","message":"Quota exceeded."}`, "RESOURCE_EXHAUSTED", true},
+		{"unknown code", `{"code":99,"message":"Unknown."}`, "99", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				io.WriteString(w, `{"path":"users/1/operations/abc","done":true,"error":`+tt.err+`}`)
+			}))
+
+			op, err := c.CloudV2.GenerateUserThumbnail(1, 1, "", false)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			_, err = op.Wait(context.Background())
+			var cloudErr *CloudError
+			if !errors.As(err, &cloudErr) {
+				t.Fatalf("error = %v, want CloudError", err)
+			}
+			if cloudErr.Code != tt.code {
+				t.Errorf("code = %s, want %s", cloudErr.Code, tt.code)
+			}
+			if cloudErr.Quota() != tt.quota {
+				t.Errorf("quota = %t, want %t", cloudErr.Quota(), tt.quota)
+			}
+		})
+	}
+}
+
+func TestOperationWaitCancelled(t *testing.T) {
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		io.WriteString(w, `{"path":"users/1/operations/abc","done":false}`)
+	}))
+
+	op, err := c.CloudV2.GenerateUserThumbnail(1, 0, "", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := op.Wait(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("error = %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestOperationCancel(t *testing.T) {
+	var request string
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		request = r.Method + " " + r.URL.Path
+		w.Header().Set("Content-Type", "application/json")
+		io.WriteString(w, `{}`)
+	}))
+
+	op := &Operation[Asset]{Path: "operations/abc", client: c, base: "assets/v1/"}
+	if err := op.Cancel(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if want := "POST /assets/v1/operations/abc:cancel"; request != want {
+		t.Errorf("request = %s, want %s", request, want)
+	}
+}
@@ -0,0 +1,185 @@
+package rbxweb
+
+import (
+	"slices"
+	"time"
+)
+
+// PresenceServiceV1 partially handles the 'presence/v1' Roblox Web API.
+type PresenceServiceV1 service
+
+// presencesPerRequest is the maximum amount of users accepted by batched
+// presence requests.
+const presencesPerRequest = 50
+
+// UserPresenceType represents the online status of a user.
+type UserPresenceType int
+
+const (
+	UserPresenceTypeOffline UserPresenceType = iota
+	UserPresenceTypeOnline
+	UserPresenceTypeInGame
+	UserPresenceTypeInStudio
+	UserPresenceTypeInvisible
+)
+
+// String returns the name of the UserPresenceType.
+func (t UserPresenceType) String() string {
+	switch t {
+	case UserPresenceTypeOffline:
+		return "Offline"
+	case UserPresenceTypeOnline:
+		return "Online"
+	case UserPresenceTypeInGame:
+		return "InGame"
+	case UserPresenceTypeInStudio:
+		return "InStudio"
+	case UserPresenceTypeInvisible:
+		return "Invisible"
+	default:
+		return "Unknown"
+	}
+}
+
+// UserPresence implements the UserPresenceResponseModel API model.
+//
+// The place, universe and game fields are only set if the user is in a game
+// visible to the authenticated user.
+type UserPresence struct {
+	Type         UserPresenceType `json:"userPresenceType"`
+	LastLocation string           `json:"lastLocation"`
+	PlaceID      PlaceID          `json:"placeId"`
+	RootPlaceID  PlaceID          `json:"rootPlaceId"`
+	GameID       string           `json:"gameId"` // Job ID
+	UniverseID   UniverseID       `json:"universeId"`
+	UserID       UserID           `json:"userId"`
+	LastOnline   string           `json:"lastOnline"`
+}
+
+// UserLastOnline implements the UserLastOnlineModel API model.
+type UserLastOnline struct {
+	UserID     UserID    `json:"userId"`
+	LastOnline time.Time `json:"lastOnline"`
+}
+
+// JoinTarget represents the server a user is in, as needed to join them.
+type JoinTarget struct {
+	UserID     UserID
+	PlaceID    PlaceID
+	UniverseID UniverseID
+	JobID      string
+}
+
+// ListUserPresences returns the presences of the given User IDs. The User IDs
+// are requested in chunks, as accepted by the API.
+func (p *PresenceServiceV1) ListUserPresences(uids []UserID) ([]UserPresence, error) {
+	var presences []UserPresence
+
+	for chunk := range slices.Chunk(uids, presencesPerRequest) {
+		upr := struct {
+			UserPresences []UserPresence `json:"userPresences"`
+		}{}
+
+		req := struct {
+			IDs []UserID `json:"userIds"`
+		}{chunk}
+
+		err := p.Client.Execute("POST", "presence", "v1/presence/users", req, &upr)
+		if err != nil {
+			return nil, err
+		}
+
+		presences = append(presences, upr.UserPresences...)
+	}
+
+	return presences, nil
+}
+
+// GetUserPresence returns the presence of the given User ID.
+//
+// If none are found, nil will be returned.
+func (p *PresenceServiceV1) GetUserPresence(uid UserID) (*UserPresence, error) {
+	return getList(p.ListUserPresences([]UserID{uid}))
+}
+
+// ListLastOnline returns the time the given User IDs were last online. The
+// User IDs are requested in chunks, as accepted by the API.
+func (p *PresenceServiceV1) ListLastOnline(uids []UserID) ([]UserLastOnline, error) {
+	var lastOnline []UserLastOnline
+
+	for chunk := range slices.Chunk(uids, presencesPerRequest) {
+		lor := struct {
+			Timestamps []UserLastOnline `json:"lastOnlineTimestamps"`
+		}{}
+
+		req := struct {
+			IDs []UserID `json:"userIds"`
+		}{chunk}
+
+		err := p.Client.Execute("POST", "presence", "v1/presence/last-online", req, &lor)
+		if err != nil {
+			return nil, err
+		}
+
+		lastOnline = append(lastOnline, lor.Timestamps...)
+	}
+
+	return lastOnline, nil
+}
+
+// GetLastOnline returns the time the given User ID was last online.
+//
+// If none are found, nil will be returned.
+func (p *PresenceServiceV1) GetLastOnline(uid UserID) (*UserLastOnline, error) {
+	return getList(p.ListLastOnline([]UserID{uid}))
+}
+
+// JoinTarget returns the server the user of the UserPresence is in. If the
+// user is not in a game, or their game is not visible, nil will be returned.
+//
+// The Universe ID is retrieved with UniversesServiceV1.ResolveUniverse if it
+// is not included in the presence.
+func (p *PresenceServiceV1) JoinTarget(up *UserPresence) (*JoinTarget, error) {
+	if up.Type != UserPresenceTypeInGame || up.PlaceID == 0 {
+		return nil, nil
+	}
+
+	jt := &JoinTarget{
+		UserID:     up.UserID,
+		PlaceID:    up.PlaceID,
+		UniverseID: up.UniverseID,
+		JobID:      up.GameID,
+	}
+
+	if jt.UniverseID == 0 {
+		uid, err := p.Client.UniversesV1.ResolveUniverse(up.PlaceID)
+		if err != nil {
+			return nil, err
+		}
+		jt.UniverseID = uid
+	}
+
+	return jt, nil
+}
+
+// ListJoinTargets returns the servers the given User IDs are in, omitting
+// users that are not in a visible game.
+func (p *PresenceServiceV1) ListJoinTargets(uids []UserID) ([]JoinTarget, error) {
+	ups, err := p.ListUserPresences(uids)
+	if err != nil {
+		return nil, err
+	}
+
+	var targets []JoinTarget
+	for i := range ups {
+		jt, err := p.JoinTarget(&ups[i])
+		if err != nil {
+			return nil, err
+		}
+		if jt != nil {
+			targets = append(targets, *jt)
+		}
+	}
+
+	return targets, nil
+}
@@ -7,47 +7,97 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"iter"
 	"net/http"
 	"net/url"
+	"slices"
 	"strings"
+	"sync"
 )
 
+const userAgent = "rbxweb/v0.0.0"
+
 // Client embeds an [http.Client], used to make Roblox API requests.
 //
 // BaseDomain is the URL domain used to execute calls to, in case an alternative
 // domain is given.
+//
+// Mirror is the base URL of the deployment setup mirror used to retrieve
+// Roblox deployments. Mirrors are the base URLs of alternative deployment
+// setup mirrors, used when a retrieval from Mirror fails.
 type Client struct {
 	http.Client
 	BaseDomain string
+	Mirror     string
+	Mirrors    []string
 
 	Security string // .ROBLOSECURITY
 	Token    string // X-CSRF-Token
+	APIKey   string // x-api-key, used by Open Cloud APIs
 
 	common service // Reuse a single struct instead of allocating one for each service on the heap.
 
-	GamesV1          *GamesServiceV1
-	ThumbnailsV1     *ThumbnailsServiceV1
-	UsersV1          *UsersServiceV1
-	AuthV2           *AuthServiceV2
-	OAuthV1          *OAuthServiceV1
-	ClientSettingsV2 *ClientSettingsServiceV2
-	AuthTokenV1      *AuthTokenServiceV1
+	placeUniverses sync.Map // PlaceID to UniverseID
+
+	GamesV1             *GamesServiceV1
+	GamesV2             *GamesServiceV2
+	ThumbnailsV1        *ThumbnailsServiceV1
+	UsersV1             *UsersServiceV1
+	AuthV2              *AuthServiceV2
+	OAuthV1             *OAuthServiceV1
+	ClientSettingsV1    *ClientSettingsServiceV1
+	ClientSettingsV2    *ClientSettingsServiceV2
+	AuthTokenV1         *AuthTokenServiceV1
+	MessagingV1         *MessagingServiceV1
+	UniversesV1         *UniversesServiceV1
+	CloudV2             *CloudServiceV2
+	AssetsV1            *AssetsServiceV1
+	Deployment          *DeploymentService
+	DevelopV1           *DevelopServiceV1
+	ShareLinksV1        *ShareLinksServiceV1
+	ExploreV1           *ExploreServiceV1
+	SearchV1            *SearchServiceV1
+	EconomyV1           *EconomyServiceV1
+	GamePassesV1        *GamePassesServiceV1
+	DeveloperProductsV1 *DeveloperProductsServiceV1
+	InventoryV1         *InventoryServiceV1
+	BadgesV1            *BadgesServiceV1
+	PresenceV1          *PresenceServiceV1
 }
 
 // NewClient returns a new Client.
 func NewClient() *Client {
 	c := &Client{
 		BaseDomain: "roblox.com",
+		Mirror:     DefaultMirror,
+		Mirrors:    slices.Clone(DefaultMirrors),
 	}
 
 	c.common.Client = c
 	c.GamesV1 = (*GamesServiceV1)(&c.common)
+	c.GamesV2 = (*GamesServiceV2)(&c.common)
 	c.ThumbnailsV1 = (*ThumbnailsServiceV1)(&c.common)
 	c.UsersV1 = (*UsersServiceV1)(&c.common)
 	c.AuthV2 = (*AuthServiceV2)(&c.common)
 	c.OAuthV1 = (*OAuthServiceV1)(&c.common)
+	c.ClientSettingsV1 = (*ClientSettingsServiceV1)(&c.common)
 	c.ClientSettingsV2 = (*ClientSettingsServiceV2)(&c.common)
 	c.AuthTokenV1 = (*AuthTokenServiceV1)(&c.common)
+	c.MessagingV1 = (*MessagingServiceV1)(&c.common)
+	c.UniversesV1 = (*UniversesServiceV1)(&c.common)
+	c.CloudV2 = (*CloudServiceV2)(&c.common)
+	c.AssetsV1 = (*AssetsServiceV1)(&c.common)
+	c.Deployment = (*DeploymentService)(&c.common)
+	c.DevelopV1 = (*DevelopServiceV1)(&c.common)
+	c.ShareLinksV1 = (*ShareLinksServiceV1)(&c.common)
+	c.ExploreV1 = (*ExploreServiceV1)(&c.common)
+	c.SearchV1 = (*SearchServiceV1)(&c.common)
+	c.EconomyV1 = (*EconomyServiceV1)(&c.common)
+	c.GamePassesV1 = (*GamePassesServiceV1)(&c.common)
+	c.DeveloperProductsV1 = (*DeveloperProductsServiceV1)(&c.common)
+	c.InventoryV1 = (*InventoryServiceV1)(&c.common)
+	c.BadgesV1 = (*BadgesServiceV1)(&c.common)
+	c.PresenceV1 = (*PresenceServiceV1)(&c.common)
 
 	return c
 }
@@ -59,27 +109,35 @@ type service struct {
 // path constructs a URL path with the given path as the format, values (if any),
 // and format parameters for the path. The encoded query will be appended to the format.
 func path(format string, query url.Values, a ...any) string {
+	p := fmt.Sprintf(format, a...)
 	if query != nil {
-		format += "?" + query.Encode()
+		// Appended after formatting, as the encoded query may contain '%'
+		p += "?" + query.Encode()
 	}
-	return fmt.Sprintf(format, a...)
+	return p
 }
 
 // NewRequest returns a new API request with the given relative path and
 // the service (subdomain) to use with the BaseDomain of the Client. If a body
 // is specified, and it is of type [url.Values], it will be added to the request
-// as application/x-www-form-urlencoded, otherwise, the body is used as
+// as application/x-www-form-urlencoded, if it is an [io.Reader], it will be
+// streamed as application/octet-stream, otherwise, the body is used as
 // application/json if non-nil.
 //
 // The request returned expects a application/json.
 //
-// The security cookie and CSRF token will be added to the request if available.
+// The security cookie, CSRF token and Open Cloud API key will be added to the
+// request if available.
 func (c *Client) NewRequest(method, service, path string, body any) (*http.Request, error) {
 	buf := new(bytes.Buffer)
+	var r io.Reader = buf
 	content := ""
 	if v, ok := body.(url.Values); ok {
 		buf.WriteString(v.Encode())
 		content = "application/x-www-form-urlencoded"
+	} else if v, ok := body.(io.Reader); ok {
+		r = v
+		content = "application/octet-stream"
 	} else if body != nil {
 		enc := json.NewEncoder(buf)
 		enc.SetEscapeHTML(false)
@@ -96,12 +154,12 @@ func (c *Client) NewRequest(method, service, path string, body any) (*http.Reque
 	}
 	url += c.BaseDomain + "/" + path
 
-	req, err := http.NewRequest(method, url, buf)
+	req, err := http.NewRequest(method, url, r)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Set("User-Agent", "rbxweb/v0.0.0")
+	req.Header.Set("User-Agent", userAgent)
 
 	if content != "" {
 		req.Header.Set("Content-Type", content)
@@ -113,6 +171,10 @@ func (c *Client) NewRequest(method, service, path string, body any) (*http.Reque
 		req.Header.Set("X-CSRF-TOKEN", c.Token)
 	}
 
+	if c.APIKey != "" {
+		req.Header.Set("x-api-key", c.APIKey)
+	}
+
 	if c.Security != "" {
 		req.AddCookie(&http.Cookie{
 			Name:  ".ROBLOSECURITY",
@@ -134,7 +196,9 @@ func (c *Client) NewRequest(method, service, path string, body any) (*http.Reque
 // If the request fails with 403 and returns X-CSRF-TOKEN, GetBody will be used from the
 // request, as the underlying type made from [NewRequest] is bytes.Buffer, and the
 // request will be tried again with the new X-CSRF-TOKEN, It will also be stored
-// and used for future requests until the cycle occurs again.
+// and used for future requests until the cycle occurs again. Requests with a streamed
+// body, which has no GetBody, are not tried again, and a StatusError is returned instead;
+// the request may be made again by the caller with the stored X-CSRF-TOKEN.
 func (c *Client) BareDo(req *http.Request) (*http.Response, error) {
 	resp, err := c.Client.Do(req)
 	if err != nil {
@@ -146,6 +210,12 @@ func (c *Client) BareDo(req *http.Request) (*http.Response, error) {
 		resp.Body.Close()
 		c.Token = t
 
+		// A streamed body has been consumed by the first request
+		if req.GetBody == nil && req.Body != nil && req.Body != http.NoBody {
+			return nil, fmt.Errorf("%w: streamed request body can not be resent with X-CSRF-TOKEN",
+				&StatusError{StatusCode: resp.StatusCode})
+		}
+
 		req = req.Clone(req.Context())
 		req.Header.Set("X-CSRF-TOKEN", c.Token)
 		if req.GetBody != nil {
@@ -189,6 +259,13 @@ func (c *Client) BareDo(req *http.Request) (*http.Response, error) {
 		return resp, errsResp
 	}
 
+	// Open Cloud APIs use their own error model
+	cloudErr := new(CloudError)
+	if err := json.Unmarshal(data, cloudErr); err == nil && cloudErr.Code != "" {
+		cloudErr.StatusCode = resp.StatusCode
+		return resp, cloudErr
+	}
+
 	// Some undocumented APIs return a single string as an error
 	var errStr string
 	if err := json.Unmarshal(data, &errStr); err == nil {
@@ -279,6 +356,133 @@ func (errs Errors) Unwrap() error {
 	return errs.Errors[0]
 }
 
+// CloudError implements the error response model of the Open Cloud APIs.
+//
+// Open Cloud errors can not be decoded as Errors: they are a single object
+// rather than a list, and their codes are names such as "RESOURCE_EXHAUSTED"
+// rather than numbers. BareDo returns a CloudError when a response is not
+// an Errors, and Quota may be used to check for quota errors.
+//
+// Version 1 of the Open Cloud APIs name the error code as 'error', and version 2
+// as 'code'; both are decoded into Code. Errors of operations, which use numeric
+// codes, are decoded into Code by the name of the numeric code.
+type CloudError struct {
+	StatusCode int    `json:"-"`
+	Code       string `json:"code"` // e.g. "RESOURCE_EXHAUSTED", "INVALID_ARGUMENT"
+	Message    string `json:"message"`
+}
+
+// cloudErrorCodes are the names of the numeric codes of operation errors,
+// indexed by the code.
+var cloudErrorCodes = []string{
+	"OK",
+	"CANCELLED",
+	"UNKNOWN",
+	"INVALID_ARGUMENT",
+	"DEADLINE_EXCEEDED",
+	"NOT_FOUND",
+	"ALREADY_EXISTS",
+	"PERMISSION_DENIED",
+	"RESOURCE_EXHAUSTED",
+	"FAILED_PRECONDITION",
+	"ABORTED",
+	"OUT_OF_RANGE",
+	"UNIMPLEMENTED",
+	"INTERNAL",
+	"UNAVAILABLE",
+	"DATA_LOSS",
+	"UNAUTHENTICATED",
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (e *CloudError) UnmarshalJSON(data []byte) error {
+	r := struct {
+		Code    json.RawMessage `json:"code"`
+		Error   string          `json:"error"`
+		Message string          `json:"message"`
+	}{}
+	if err := json.Unmarshal(data, &r); err != nil {
+		return err
+	}
+
+	// Errors within operations use numeric codes instead
+	if err := json.Unmarshal(r.Code, &e.Code); err != nil {
+		var n int
+		if err := json.Unmarshal(r.Code, &n); err == nil && n >= 0 && n < len(cloudErrorCodes) {
+			e.Code = cloudErrorCodes[n]
+		} else {
+			e.Code = string(r.Code)
+		}
+	}
+	if e.Code == "" {
+		e.Code = r.Error
+	}
+	e.Message = r.Message
+	return nil
+}
+
+// Error implements the error interface.
+func (e *CloudError) Error() string {
+	return fmt.Sprintf("response %s: %s", e.Code, e.Message)
+}
+
+// Quota reports whether the error was caused by exceeding a usage quota
+// or rate limit.
+func (e *CloudError) Quota() bool {
+	return e.StatusCode == http.StatusTooManyRequests || e.Code == "RESOURCE_EXHAUSTED"
+}
+
+// isAPIError reports whether the error was returned by the API, rather
+// than a failure to perform the request.
+func isAPIError(err error) bool {
+	var errs *Errors
+	var statusErr *StatusError
+	var cloudErr *CloudError
+	return errors.As(err, &errs) || errors.As(err, &statusErr) || errors.As(err, &cloudErr)
+}
+
+// Page implements the cursor-paged response model of the API.
+type Page[T any] struct {
+	Previous string `json:"previousPageCursor"`
+	Next     string `json:"nextPageCursor"`
+	Data     []T    `json:"data"`
+}
+
+// SortOrder represents the sort order of a paged response.
+type SortOrder string
+
+const (
+	SortOrderAsc  SortOrder = "Asc" // Default
+	SortOrderDesc SortOrder = "Desc"
+)
+
+// paginate returns an iterator over all values of each page retrieved with
+// get, starting from the first page. Iteration stops at the first error.
+func paginate[T any](get func(cursor string) (*Page[T], error)) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		cursor := ""
+		for {
+			p, err := get(cursor)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+
+			for _, v := range p.Data {
+				if !yield(v, nil) {
+					return
+				}
+			}
+
+			if p.Next == "" {
+				return
+			}
+			cursor = p.Next
+		}
+	}
+}
+
 func formatSlice[T any](values []T) []string {
 	if len(values) == 0 {
 		return nil
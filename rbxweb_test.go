@@ -0,0 +1,97 @@
+package rbxweb
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// newTestClient returns a Client with requests to all of its API services
+// served by the handler.
+func newTestClient(t *testing.T, h http.Handler) *Client {
+	t.Helper()
+
+	srv := httptest.NewTLSServer(h)
+	t.Cleanup(srv.Close)
+
+	c := NewClient()
+	c.Client = *srv.Client()
+	// The test certificate is valid for example.com and its subdomains
+	c.BaseDomain = "example.com"
+	c.Transport.(*http.Transport).DialContext = func(ctx context.Context, network, _ string) (net.Conn, error) {
+		var d net.Dialer
+		return d.DialContext(ctx, network, srv.Listener.Addr().String())
+	}
+
+	return c
+}
+
+// newTokenHandler returns a handler which requires the X-CSRF-TOKEN
+// "token", and records the bodies of the requests made.
+func newTokenHandler(bodies *[]string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		*bodies = append(*bodies, string(b))
+
+		if r.Header.Get("X-CSRF-TOKEN") != "token" {
+			w.Header().Set("X-CSRF-TOKEN", "token")
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		io.WriteString(w, `{"versionNumber":2}`)
+	})
+}
+
+func TestBareDoTokenRetry(t *testing.T) {
+	var bodies []string
+	c := newTestClient(t, newTokenHandler(&bodies))
+
+	err := c.Execute("POST", "apis", "test", map[string]string{"a": "b"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{"{\"a\":\"b\"}\n", "{\"a\":\"b\"}\n"}
+	if !slices.Equal(bodies, want) {
+		t.Errorf("bodies = %q, want %q", bodies, want)
+	}
+	if c.Token != "token" {
+		t.Errorf("token = %q, want %q", c.Token, "token")
+	}
+}
+
+func TestBareDoTokenRetryStreamed(t *testing.T) {
+	var bodies []string
+	c := newTestClient(t, newTokenHandler(&bodies))
+
+	_, err := c.UniversesV1.PublishPlace(1, 1818, VersionTypePublished,
+		strings.NewReader("<roblox!place"))
+
+	var statusErr *StatusError
+	if !errors.As(err, &statusErr) || statusErr.StatusCode != http.StatusForbidden {
+		t.Fatalf("error = %v, want %d", err, http.StatusForbidden)
+	}
+	if want := []string{"<roblox!place"}; !slices.Equal(bodies, want) {
+		t.Errorf("bodies = %q, want %q", bodies, want)
+	}
+	if c.Token != "token" {
+		t.Errorf("token = %q, want %q", c.Token, "token")
+	}
+
+	// The token is used for the next request
+	v, err := c.UniversesV1.PublishPlace(1, 1818, VersionTypePublished,
+		strings.NewReader("<roblox!place"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != 2 {
+		t.Errorf("version = %d, want 2", v)
+	}
+}
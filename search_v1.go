@@ -0,0 +1,43 @@
+package rbxweb
+
+import (
+	"net/url"
+)
+
+// SearchServiceV1 partially handles the undocumented 'search-api' Roblox Web API.
+type SearchServiceV1 service
+
+// SearchGames returns a page of the games matching the query, and the token
+// of the next page, if any. The page token is optional.
+func (s *SearchServiceV1) SearchGames(sessionID, query, pageToken string) ([]GameTile, string, error) {
+	sr := struct {
+		Results []struct {
+			Type     string     `json:"contentGroupType"`
+			Contents []GameTile `json:"contents"`
+		} `json:"searchResults"`
+		Next string `json:"nextPageToken"`
+	}{}
+
+	q := url.Values{
+		"searchQuery": {query},
+		"sessionId":   {sessionID},
+		"pageType":    {"all"},
+	}
+	if pageToken != "" {
+		q.Set("pageToken", pageToken)
+	}
+
+	err := s.Client.Execute("GET", "apis", path("search-api/omni-search", q), nil, &sr)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var tiles []GameTile
+	for _, r := range sr.Results {
+		if r.Type == "Game" {
+			tiles = append(tiles, r.Contents...)
+		}
+	}
+
+	return tiles, sr.Next, nil
+}
@@ -0,0 +1,90 @@
+package rbxweb
+
+import (
+	"fmt"
+)
+
+// ShareLinksServiceV1 partially handles the undocumented 'sharelinks/v1' Roblox Web API.
+type ShareLinksServiceV1 service
+
+// ShareLinkType represents the kind of a share link.
+type ShareLinkType string
+
+const (
+	ShareLinkTypeServer            ShareLinkType = "Server"
+	ShareLinkTypeExperienceInvite  ShareLinkType = "ExperienceInvite"
+	ShareLinkTypeExperienceDetails ShareLinkType = "ExperienceDetails"
+	ShareLinkTypeProfile           ShareLinkType = "Profile"
+)
+
+// ShareLink is a representation of an unknown model returned by resolve-link.
+type ShareLink struct {
+	PrivateServerInvite *struct {
+		Status          string          `json:"status"`
+		OwnerID         UserID          `json:"ownerUserId"`
+		UniverseID      UniverseID      `json:"universeId"`
+		PlaceID         PlaceID         `json:"placeId"`
+		LinkCode        string          `json:"linkCode"`
+		PrivateServerID PrivateServerID `json:"privateServerId"`
+	} `json:"privateServerInviteData,omitempty"`
+	ExperienceInvite *struct {
+		Status     string  `json:"status"`
+		InviterID  UserID  `json:"inviterId"`
+		PlaceID    PlaceID `json:"placeId"`
+		InstanceID string  `json:"instanceId"`
+	} `json:"experienceInviteData,omitempty"`
+	ExperienceDetails *struct {
+		Status     string     `json:"status"`
+		UniverseID UniverseID `json:"universeId"`
+		PlaceID    PlaceID    `json:"placeId"`
+	} `json:"experienceDetailsInviteData,omitempty"`
+	Profile *struct {
+		UserID UserID `json:"userId"`
+	} `json:"profileLinkResolutionResponseData,omitempty"`
+}
+
+// GetShareLink returns the share link of the named code and type.
+func (s *ShareLinksServiceV1) GetShareLink(code string, typ ShareLinkType) (*ShareLink, error) {
+	var sl ShareLink
+
+	req := struct {
+		Code string        `json:"linkId"`
+		Type ShareLinkType `json:"linkType"`
+	}{code, typ}
+
+	err := s.Client.Execute("POST", "apis", "sharelinks/v1/resolve-link", req, &sl)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sl, nil
+}
+
+// ResolveLink returns the concrete target of the share link of the Link.
+// If the Link is not a share link, it is returned as-is.
+func (s *ShareLinksServiceV1) ResolveLink(l *Link) (*Link, error) {
+	if l.ShareCode == "" {
+		return l, nil
+	}
+
+	sl, err := s.GetShareLink(l.ShareCode, l.ShareType)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case sl.PrivateServerInvite != nil:
+		return &Link{
+			PlaceID:               sl.PrivateServerInvite.PlaceID,
+			PrivateServerLinkCode: sl.PrivateServerInvite.LinkCode,
+		}, nil
+	case sl.ExperienceInvite != nil:
+		return &Link{PlaceID: sl.ExperienceInvite.PlaceID}, nil
+	case sl.ExperienceDetails != nil:
+		return &Link{PlaceID: sl.ExperienceDetails.PlaceID}, nil
+	case sl.Profile != nil:
+		return &Link{UserID: sl.Profile.UserID}, nil
+	default:
+		return nil, fmt.Errorf("share link %s: unhandled type %s", l.ShareCode, l.ShareType)
+	}
+}
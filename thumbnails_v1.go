@@ -2,6 +2,7 @@ package rbxweb
 
 import (
 	"net/url"
+	"slices"
 	"strconv"
 )
 
@@ -83,3 +84,99 @@ func (t *ThumbnailsServiceV1) ListGamesIcons(uids []UniverseID, opts *GameIconOp
 func (t *ThumbnailsServiceV1) GetGameIcon(universeID UniverseID, opts *GameIconOptions) (*Thumbnail, error) {
 	return getList(t.ListGamesIcons([]UniverseID{universeID}, opts))
 }
+
+// GameThumbnailOptions provides parameters for retrieving game thumbnails.
+type GameThumbnailOptions struct {
+	Policy           ReturnPolicy
+	Size             string // one of 256x144, 384x216, 480x270, 576x324, 768x432
+	Format           ThumbnailFormat
+	Rectangular      bool
+	CountPerUniverse int  // Maximum amount of thumbnails per universe
+	Defaults         bool // Whether to return default thumbnails for universes without any
+}
+
+// GameThumbnails implements the UniverseThumbnailsResponse API model.
+type GameThumbnails struct {
+	UniverseID UniverseID  `json:"universeId"`
+	Error      *Error      `json:"error,omitempty"`
+	Thumbnails []Thumbnail `json:"thumbnails"`
+}
+
+// ListGamesThumbnails returns the thumbnails of each given universeID,
+// grouped by universe, based on the given options.
+func (t *ThumbnailsServiceV1) ListGamesThumbnails(uids []UniverseID, opts *GameThumbnailOptions) ([]GameThumbnails, error) {
+	r := struct {
+		Data []GameThumbnails `json:"data"`
+	}{}
+
+	q := url.Values{"universeIds": formatSlice(uids)}
+	if opts != nil {
+		q.Add("returnPolicy", string(opts.Policy))
+		q.Add("size", opts.Size)
+		q.Add("format", string(opts.Format))
+		q.Add("isCircular", strconv.FormatBool(!opts.Rectangular))
+		if opts.CountPerUniverse > 0 {
+			q.Add("countPerUniverse", strconv.Itoa(opts.CountPerUniverse))
+		}
+		q.Add("defaults", strconv.FormatBool(opts.Defaults))
+	}
+
+	err := t.Client.Execute("GET", "thumbnails", path("v1/games/multiget/thumbnails", q), nil, &r)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Data, nil
+}
+
+// GetGameThumbnails returns the thumbnails of the given universeID,
+// based on the given options.
+//
+// If none are found, nil will be returned.
+func (t *ThumbnailsServiceV1) GetGameThumbnails(universeID UniverseID, opts *GameThumbnailOptions) (*GameThumbnails, error) {
+	return getList(t.ListGamesThumbnails([]UniverseID{universeID}, opts))
+}
+
+// BadgeIconOptions provides parameters for retrieving badge icons.
+type BadgeIconOptions struct {
+	Format      ThumbnailFormat
+	Rectangular bool
+}
+
+// ListBadgesIcons returns a list of Thumbnails for the given list of badgeIDs,
+// based on the thumbnail format and whether the thumbnail is circular. The
+// badgeIDs are requested in chunks, as accepted by the API.
+func (t *ThumbnailsServiceV1) ListBadgesIcons(ids []BadgeID, opts *BadgeIconOptions) ([]Thumbnail, error) {
+	var thumbnails []Thumbnail
+
+	for chunk := range slices.Chunk(ids, badgesPerRequest) {
+		r := struct {
+			Data []Thumbnail `json:"data"`
+		}{}
+
+		q := url.Values{
+			"badgeIds": formatSlice(chunk),
+			"size":     {"150x150"}, // only size available
+		}
+		if opts != nil {
+			q.Add("format", string(opts.Format))
+			q.Add("isCircular", strconv.FormatBool(!opts.Rectangular))
+		}
+
+		err := t.Client.Execute("GET", "thumbnails", path("v1/badges/icons", q), nil, &r)
+		if err != nil {
+			return nil, err
+		}
+
+		thumbnails = append(thumbnails, r.Data...)
+	}
+
+	return thumbnails, nil
+}
+
+// GetBadgeIcon returns a Thumbnail for the given badgeID.
+//
+// If none are found, nil will be returned.
+func (t *ThumbnailsServiceV1) GetBadgeIcon(id BadgeID, opts *BadgeIconOptions) (*Thumbnail, error) {
+	return getList(t.ListBadgesIcons([]BadgeID{id}, opts))
+}
@@ -0,0 +1,93 @@
+package rbxweb
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+)
+
+// UniversesServiceV1 partially handles the 'universes/v1' Roblox Open Cloud API.
+type UniversesServiceV1 service
+
+// VersionType represents how a place version is saved.
+type VersionType string
+
+const (
+	VersionTypeSaved     VersionType = "Saved"
+	VersionTypePublished VersionType = "Published"
+)
+
+// PublishPlace uploads the place file read from r as a new version of the given
+// Place ID in the Universe ID, and returns the new version number.
+//
+// The place file is streamed, and may be either a binary (.rbxl) or XML (.rbxlx)
+// place file; the format is detected from the header of the file.
+//
+// Requests require an API key with the universe-places:write permission to be
+// set in the Client.
+func (u *UniversesServiceV1) PublishPlace(uid UniverseID, pid PlaceID, vt VersionType, r io.Reader) (int64, error) {
+	br := bufio.NewReader(r)
+	content := "application/octet-stream"
+	if magic, _ := br.Peek(8); bytes.HasPrefix(magic, []byte("<roblox")) &&
+		!bytes.Equal(magic, []byte("<roblox!")) {
+		content = "application/xml"
+	}
+
+	q := url.Values{"versionType": {string(vt)}}
+	req, err := u.Client.NewRequest("POST", "apis",
+		path("universes/v1/%d/places/%d/versions", q, uid, pid), io.Reader(br))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", content)
+
+	resp := struct {
+		Version int64 `json:"versionNumber"`
+	}{}
+	if _, err := u.Client.Do(req, &resp); err != nil {
+		return 0, err
+	}
+
+	return resp.Version, nil
+}
+
+// GetPlaceUniverse returns the Universe ID of the given Place ID.
+func (u *UniversesServiceV1) GetPlaceUniverse(pid PlaceID) (UniverseID, error) {
+	resp := struct {
+		ID UniverseID `json:"universeId"`
+	}{}
+
+	err := u.Client.Execute("GET", "apis", path("universes/v1/places/%d/universe", nil, pid), nil, &resp)
+	if err != nil {
+		return 0, err
+	}
+
+	return resp.ID, nil
+}
+
+// ResolveUniverse returns the Universe ID of the given Place ID, retrieved
+// with GetPlaceUniverse, or from the place's details if that fails. Resolved
+// Universe IDs are cached for the lifetime of the Client.
+func (u *UniversesServiceV1) ResolveUniverse(pid PlaceID) (UniverseID, error) {
+	if uid, ok := u.Client.placeUniverses.Load(pid); ok {
+		return uid.(UniverseID), nil
+	}
+
+	uid, err := u.GetPlaceUniverse(pid)
+	if err != nil || uid == 0 {
+		pd, pdErr := u.Client.GamesV1.GetPlaceDetail(pid)
+		if pdErr != nil {
+			return 0, errors.Join(err, pdErr)
+		}
+		if pd == nil || pd.UniverseID == 0 {
+			return 0, fmt.Errorf("place %d: universe not found", pid)
+		}
+		uid = pd.UniverseID
+	}
+
+	u.Client.placeUniverses.Store(pid, uid)
+	return uid, nil
+}
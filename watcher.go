@@ -0,0 +1,157 @@
+package rbxweb
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"net/http"
+	"time"
+)
+
+// VersionEventType represents the kind of a VersionEvent.
+type VersionEventType int
+
+const (
+	VersionEventError             VersionEventType = iota // Polling failed
+	VersionEventUpdated                                   // A new version was deployed
+	VersionEventNextAnnounced                             // An upcoming version was announced
+	VersionEventChannelReassigned                         // The user was assigned to another channel
+)
+
+// VersionEvent represents a change observed by a VersionWatcher.
+type VersionEvent struct {
+	Type     VersionEventType
+	Version  *ClientVersion // Current client version, if known
+	Previous *ClientVersion // Previous client version, for VersionEventUpdated
+	Channel  *UserChannel   // New user channel, for VersionEventChannelReassigned
+	Err      error          // Error encountered, for VersionEventError
+}
+
+// VersionWatcher polls the client version of a BinaryType on a deployment
+// channel for changes.
+type VersionWatcher struct {
+	Client *Client
+	Type   BinaryType
+	// Channel is the deployment channel to watch; if empty, the channel of
+	// the logged in user is followed.
+	Channel  string
+	Interval time.Duration
+	Jitter   time.Duration // Maximum random delay added to each interval
+
+	etag    string
+	current *ClientVersion
+	channel *UserChannel
+}
+
+// NewVersionWatcher returns a new VersionWatcher polling every minute.
+func NewVersionWatcher(c *Client, bt BinaryType, channel string) *VersionWatcher {
+	return &VersionWatcher{
+		Client:   c,
+		Type:     bt,
+		Channel:  channel,
+		Interval: time.Minute,
+		Jitter:   10 * time.Second,
+	}
+}
+
+// Watch starts polling in the background, and returns the channel events are
+// sent to. The first poll establishes the current version without reporting it
+// as updated. Polling stops and the channel is closed once the context
+// is cancelled.
+func (w *VersionWatcher) Watch(ctx context.Context) <-chan VersionEvent {
+	events := make(chan VersionEvent)
+
+	go func() {
+		defer close(events)
+
+		for {
+			for _, ev := range w.poll(ctx) {
+				select {
+				case events <- ev:
+				case <-ctx.Done():
+					return
+				}
+			}
+
+			delay := w.Interval
+			if w.Jitter > 0 {
+				delay += rand.N(w.Jitter)
+			}
+
+			t := time.NewTimer(delay)
+			select {
+			case <-ctx.Done():
+				t.Stop()
+				return
+			case <-t.C:
+			}
+		}
+	}()
+
+	return events
+}
+
+func (w *VersionWatcher) poll(ctx context.Context) []VersionEvent {
+	var events []VersionEvent
+	channel, token := w.Channel, ""
+
+	if w.Channel == "" {
+		uc, err := w.Client.ClientSettingsV2.GetUserChannel(&w.Type)
+		if err != nil {
+			return []VersionEvent{{Type: VersionEventError, Version: w.current, Err: err}}
+		}
+
+		if w.channel != nil && w.channel.Channel != uc.Channel {
+			events = append(events, VersionEvent{
+				Type:    VersionEventChannelReassigned,
+				Version: w.current,
+				Channel: uc,
+			})
+			w.etag = ""
+		}
+		w.channel = uc
+
+		channel = uc.Channel
+		if uc.Assignment.Private() {
+			token = uc.Token
+		}
+	}
+	if IsLiveChannel(channel) {
+		channel = ""
+	}
+
+	req, err := w.Client.ClientSettingsV2.newClientVersionRequest(w.Type, channel, token)
+	if err != nil {
+		return append(events, VersionEvent{Type: VersionEventError, Version: w.current, Err: err})
+	}
+	if w.etag != "" {
+		req.Header.Set("If-None-Match", w.etag)
+	}
+
+	cv := new(ClientVersion)
+	resp, err := w.Client.Do(req.WithContext(ctx), cv)
+	var statusErr *StatusError
+	if errors.As(err, &statusErr) && statusErr.StatusCode == http.StatusNotModified {
+		return events
+	} else if err != nil {
+		return append(events, VersionEvent{Type: VersionEventError, Version: w.current, Err: err})
+	}
+	w.etag = resp.Header.Get("ETag")
+
+	if w.current != nil && w.current.GUID != cv.GUID {
+		events = append(events, VersionEvent{
+			Type:     VersionEventUpdated,
+			Version:  cv,
+			Previous: w.current,
+		})
+	}
+	if w.current != nil && cv.NextGUID != "" && w.current.NextGUID != cv.NextGUID {
+		events = append(events, VersionEvent{
+			Type:    VersionEventNextAnnounced,
+			Version: cv,
+		})
+	}
+	w.current = cv
+
+	return events
+}
@@ -0,0 +1,58 @@
+package rbxweb
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"testing"
+)
+
+func TestVersionWatcherPoll(t *testing.T) {
+	versions := []ClientVersion{
+		{GUID: "version-a", NextGUID: "version-b"}, // established, not announced
+		{GUID: "version-a", NextGUID: "version-b"}, // not modified
+		{GUID: "version-a", NextGUID: "version-c"},
+		{GUID: "version-c"},
+	}
+	poll := 0
+
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/client-version/WindowsPlayer" {
+			t.Errorf("path = %s", r.URL.Path)
+		}
+
+		cv := versions[poll]
+		etag := fmt.Sprintf(`"%s-%s"`, cv.GUID, cv.NextGUID)
+		if r.Header.Get("If-None-Match") == etag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+
+		w.Header().Set("ETag", etag)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"clientVersionUpload":%q,"nextClientVersionUpload":%q}`, cv.GUID, cv.NextGUID)
+	}))
+
+	w := NewVersionWatcher(c, BinaryTypeWindowsPlayer, ChannelLive)
+
+	want := [][]VersionEventType{
+		nil,
+		nil,
+		{VersionEventNextAnnounced},
+		{VersionEventUpdated},
+	}
+	for poll = range versions {
+		var got []VersionEventType
+		for _, ev := range w.poll(context.Background()) {
+			if ev.Err != nil {
+				t.Fatalf("poll %d: %v", poll, ev.Err)
+			}
+			got = append(got, ev.Type)
+		}
+
+		if !slices.Equal(got, want[poll]) {
+			t.Errorf("poll %d: events = %v, want %v", poll, got, want[poll])
+		}
+	}
+}